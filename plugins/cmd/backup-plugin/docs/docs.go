@@ -0,0 +1,1979 @@
+// Package docs Code generated by swaggo/swag. DO NOT EDIT
+package docs
+
+import "github.com/swaggo/swag"
+
+const docTemplate = `{
+    "schemes": {{ marshal .Schemes }},
+    "swagger": "2.0",
+    "info": {
+        "description": "{{escape .Description}}",
+        "title": "{{.Title}}",
+        "termsOfService": "http://swagger.io/terms/",
+        "contact": {
+            "name": "Krateo Support",
+            "url": "https://krateo.io",
+            "email": "contact@krateoplatformops.io"
+        },
+        "license": {
+            "name": "Apache 2.0",
+            "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+        },
+        "version": "{{.Version}}"
+    },
+    "host": "{{.Host}}",
+    "basePath": "{{.BasePath}}",
+    "paths": {
+        "/projects/{projectId}/providers/Aruba.Storage/backupJobs": {
+            "get": {
+                "description": "List backup jobs on Aruba Cloud using the provided project details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List backup jobs on Aruba Cloud",
+                "operationId": "list-backup-jobs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort expression",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Projection expression",
+                        "name": "projection",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A list of backup jobs",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedBackupJobListResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new backup job on Aruba Cloud using the provided project details.\nThe job starts an ad-hoc backup of the source. Backup jobs cannot be updated, deleting one deletes its backup.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create a new backup job on Aruba Cloud",
+                "operationId": "post-backup-job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Backup job creation request body",
+                        "name": "backupJobCreate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedCreateBackupJobRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Backup job details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedBackupJobResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Storage/backupJobs/{backupJobId}/restores": {
+            "get": {
+                "description": "List restores on Aruba Cloud using the provided project and backup job details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List restores on Aruba Cloud",
+                "operationId": "list-backup-restores",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Backup Job ID",
+                        "name": "backupJobId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort expression",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Projection expression",
+                        "name": "projection",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A list of restores",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedBackupRestoreListResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new restore on Aruba Cloud using the provided project and backup job details.\nThe backup is restored on the source of the backup job, unless properties.target references another volume or cloud server.\nRestores cannot be updated nor deleted once requested.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create a new restore on Aruba Cloud",
+                "operationId": "post-backup-restore",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Backup Job ID",
+                        "name": "backupJobId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Restore creation request body",
+                        "name": "backupRestoreCreate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedCreateBackupRestoreRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Restore details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedBackupRestoreResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Storage/backupJobs/{backupJobId}/restores/{id}": {
+            "get": {
+                "description": "Get a restore from Aruba Cloud using the provided project, backup job and restore details.\nThe progress of the restore is reported in properties.progress and its result in properties.result, e.g. Running, Succeeded or Failed.\nproperties.completed is true once the restore has ended, successfully or not.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a restore from Aruba Cloud",
+                "operationId": "get-backup-restore",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Backup Job ID",
+                        "name": "backupJobId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Restore ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag",
+                        "name": "ignoreDeletedStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Restore details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedBackupRestoreResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Storage/backupJobs/{id}": {
+            "get": {
+                "description": "Get a backup job from Aruba Cloud using the provided project and backup job details.\nThe progress of the job is reported in properties.progress and its result in properties.result, e.g. Running, Succeeded or Failed.\nproperties.completed is true once the job has ended, successfully or not.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a backup job from Aruba Cloud",
+                "operationId": "get-backup-job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Backup Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag",
+                        "name": "ignoreDeletedStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Backup job details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedBackupJobResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a backup job on Aruba Cloud using the provided project and backup job details.\nDeleting a backup job that does not exist or is already in 'Deleted' state is considered successful.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete a backup job on Aruba Cloud",
+                "operationId": "delete-backup-job",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Backup Job ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Storage/backupPolicies": {
+            "get": {
+                "description": "List backup policies on Aruba Cloud using the provided project details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List backup policies on Aruba Cloud",
+                "operationId": "list-backup-policies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort expression",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Projection expression",
+                        "name": "projection",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A list of backup policies",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedBackupPolicyListResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new backup policy on Aruba Cloud using the provided project details.\nAruba Cloud starts a backup job for every target of the policy at each run of its schedule.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create a new backup policy on Aruba Cloud",
+                "operationId": "post-backup-policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Backup policy creation request body",
+                        "name": "backupPolicyCreate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedCreateBackupPolicyRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Backup policy details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedBackupPolicyResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Storage/backupPolicies/{id}": {
+            "get": {
+                "description": "Get a backup policy from Aruba Cloud using the provided project and backup policy details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a backup policy from Aruba Cloud",
+                "operationId": "get-backup-policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Backup Policy ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag",
+                        "name": "ignoreDeletedStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Backup policy details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedBackupPolicyResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a backup policy on Aruba Cloud using the provided project and backup policy details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Update a backup policy on Aruba Cloud",
+                "operationId": "put-backup-policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Backup Policy ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Backup policy update request body",
+                        "name": "backupPolicyUpdate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedUpdateBackupPolicyRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Backup policy details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedBackupPolicyResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a backup policy on Aruba Cloud using the provided project and backup policy details.\nDeleting a backup policy that does not exist or is already in 'Deleted' state is considered successful.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete a backup policy on Aruba Cloud",
+                "operationId": "delete-backup-policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Backup Policy ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "ProblemDetails": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "description": "Detail is a human-readable explanation of the error.",
+                    "type": "string"
+                },
+                "instance": {
+                    "description": "Instance is the path of the request that caused the error.",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status is the HTTP status code of the response.",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "Title is a short summary of the error type.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type is a URI identifying the error type.",
+                    "type": "string"
+                },
+                "upstream": {
+                    "description": "Upstream is the original error body returned by Aruba Cloud, if any.",
+                    "type": "object"
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.BackupJobPropertiesDto": {
+            "type": "object",
+            "properties": {
+                "retentionDays": {
+                    "description": "RetentionDays is the number of days the backup is kept, forever if empty.",
+                    "type": "integer"
+                },
+                "source": {
+                    "description": "Source is the volume or cloud server backed up,\ne.g. /projects/\u003cPROJECT_ID\u003e/providers/Aruba.Compute/cloudServers/\u003cCLOUD_SERVER_ID\u003e.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.BackupJobPropertiesResponseDto": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "description": "Completed is true once the job has ended, successfully or not. It is computed by the plugin from Result.",
+                    "type": "boolean"
+                },
+                "completedAt": {
+                    "description": "CompletedAt is the end time of the job, in RFC 3339 format.",
+                    "type": "string"
+                },
+                "linkedResources": {
+                    "description": "LinkedResources is a list of the resources linked to the job, e.g. its source.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_backup-plugin_handlers.LinkedResourceResponseDto"
+                    }
+                },
+                "policy": {
+                    "description": "Policy is the backup policy that started the job, empty for ad-hoc jobs.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "progress": {
+                    "description": "Progress is the percentage of the backup completed, from 0 to 100.",
+                    "type": "integer"
+                },
+                "result": {
+                    "description": "Result is the result of the job, e.g. Pending, Running, Succeeded or Failed.",
+                    "type": "string"
+                },
+                "retentionDays": {
+                    "description": "RetentionDays is the number of days the backup is kept.",
+                    "type": "integer"
+                },
+                "sizeGb": {
+                    "description": "SizeGb is the size of the backup.",
+                    "type": "integer"
+                },
+                "source": {
+                    "description": "Source is the volume or cloud server backed up.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "startedAt": {
+                    "description": "StartedAt is the start time of the job, in RFC 3339 format.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.BackupPolicyPropertiesDto": {
+            "type": "object",
+            "properties": {
+                "retentionDays": {
+                    "description": "RetentionDays is the number of days the backups are kept, at least 1.",
+                    "type": "integer"
+                },
+                "schedule": {
+                    "description": "Schedule is the time at which the targets are backed up.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.ScheduleDto"
+                        }
+                    ]
+                },
+                "targets": {
+                    "description": "Targets are the volumes and cloud servers backed up,\ne.g. /projects/\u003cPROJECT_ID\u003e/providers/Aruba.Storage/blockStorages/\u003cVOLUME_ID\u003e.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_backup-plugin_handlers.ReferenceDto"
+                    }
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.BackupPolicyPropertiesResponseDto": {
+            "type": "object",
+            "properties": {
+                "linkedResources": {
+                    "description": "LinkedResources is a list of the resources linked to the policy, e.g. its targets.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_backup-plugin_handlers.LinkedResourceResponseDto"
+                    }
+                },
+                "nextRun": {
+                    "description": "NextRun is the time of the next run of the policy, in RFC 3339 format.",
+                    "type": "string"
+                },
+                "retentionDays": {
+                    "description": "RetentionDays is the number of days the backups are kept.",
+                    "type": "integer"
+                },
+                "schedule": {
+                    "description": "Schedule is the time at which the targets are backed up.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.ScheduleDto"
+                        }
+                    ]
+                },
+                "targets": {
+                    "description": "Targets are the volumes and cloud servers backed up.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_backup-plugin_handlers.ReferenceDto"
+                    }
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.BackupRestorePropertiesDto": {
+            "type": "object",
+            "properties": {
+                "target": {
+                    "description": "Target is the volume or cloud server the backup is restored on, the source of the backup job if empty.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.BackupRestorePropertiesResponseDto": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "description": "Completed is true once the restore has ended, successfully or not. It is computed by the plugin from Result.",
+                    "type": "boolean"
+                },
+                "completedAt": {
+                    "description": "CompletedAt is the end time of the restore, in RFC 3339 format.",
+                    "type": "string"
+                },
+                "linkedResources": {
+                    "description": "LinkedResources is a list of the resources linked to the restore, e.g. its target.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_backup-plugin_handlers.LinkedResourceResponseDto"
+                    }
+                },
+                "progress": {
+                    "description": "Progress is the percentage of the restore completed, from 0 to 100.",
+                    "type": "integer"
+                },
+                "result": {
+                    "description": "Result is the result of the restore, e.g. Pending, Running, Succeeded or Failed.",
+                    "type": "string"
+                },
+                "startedAt": {
+                    "description": "StartedAt is the start time of the restore, in RFC 3339 format.",
+                    "type": "string"
+                },
+                "target": {
+                    "description": "Target is the volume or cloud server the backup is restored on.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.CategoryResponseDto": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name is the name of the category.",
+                    "type": "string"
+                },
+                "provider": {
+                    "description": "Provider is the provider of the category.",
+                    "type": "string"
+                },
+                "typology": {
+                    "description": "Typology is the typology of the category.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.TypologyResponseDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.DisableStatusInfoResponseDto": {
+            "type": "object",
+            "properties": {
+                "isDisabled": {
+                    "description": "IsDisabled indicates if the resource is disabled.",
+                    "type": "boolean"
+                },
+                "previousStatus": {
+                    "description": "PreviousStatus is the previous status of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.PreviousStatusResponseDto"
+                        }
+                    ]
+                },
+                "reasons": {
+                    "description": "Reasons is a list of reasons for the disabled status.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.FlattenedBackupJobListResponseDto": {
+            "type": "object",
+            "properties": {
+                "first": {
+                    "description": "First is the URI of the first page.",
+                    "type": "string"
+                },
+                "last": {
+                    "description": "Last is the URI of the last page.",
+                    "type": "string"
+                },
+                "next": {
+                    "description": "Next is the URI of the next page.",
+                    "type": "string"
+                },
+                "prev": {
+                    "description": "Prev is the URI of the previous page.",
+                    "type": "string"
+                },
+                "self": {
+                    "description": "Self is the URI of the current page.",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total is the total number of backup jobs.",
+                    "type": "integer"
+                },
+                "values": {
+                    "description": "Values is a list of flattened backup jobs.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedBackupJobResponseDto"
+                    }
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.FlattenedBackupJobResponseDto": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Category is the category of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.CategoryResponseDto"
+                        }
+                    ]
+                },
+                "createdBy": {
+                    "description": "CreatedBy is the user who created the resource.",
+                    "type": "string"
+                },
+                "createdUser": {
+                    "description": "CreatedUser is the user who created the resource.",
+                    "type": "string"
+                },
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the resource.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the resource.",
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Location is the region where the resource is located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.LocationResponseDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name is the name of the resource.",
+                    "type": "string"
+                },
+                "project": {
+                    "description": "Project is the project where the resource belongs.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.ProjectResponseDto"
+                        }
+                    ]
+                },
+                "properties": {
+                    "description": "Properties contains the properties of the backup job.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.BackupJobPropertiesResponseDto"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Status contains the status of the backup job.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.StatusResponseDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updateDate": {
+                    "description": "UpdateDate is the last update date of the resource.",
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "description": "UpdatedBy is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "updatedUser": {
+                    "description": "UpdatedUser is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "uri": {
+                    "description": "URI is the URI of the resource.",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the version of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.FlattenedBackupPolicyListResponseDto": {
+            "type": "object",
+            "properties": {
+                "first": {
+                    "description": "First is the URI of the first page.",
+                    "type": "string"
+                },
+                "last": {
+                    "description": "Last is the URI of the last page.",
+                    "type": "string"
+                },
+                "next": {
+                    "description": "Next is the URI of the next page.",
+                    "type": "string"
+                },
+                "prev": {
+                    "description": "Prev is the URI of the previous page.",
+                    "type": "string"
+                },
+                "self": {
+                    "description": "Self is the URI of the current page.",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total is the total number of backup policies.",
+                    "type": "integer"
+                },
+                "values": {
+                    "description": "Values is a list of flattened backup policies.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedBackupPolicyResponseDto"
+                    }
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.FlattenedBackupPolicyResponseDto": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Category is the category of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.CategoryResponseDto"
+                        }
+                    ]
+                },
+                "createdBy": {
+                    "description": "CreatedBy is the user who created the resource.",
+                    "type": "string"
+                },
+                "createdUser": {
+                    "description": "CreatedUser is the user who created the resource.",
+                    "type": "string"
+                },
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the resource.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the resource.",
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Location is the region where the resource is located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.LocationResponseDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name is the name of the resource.",
+                    "type": "string"
+                },
+                "project": {
+                    "description": "Project is the project where the resource belongs.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.ProjectResponseDto"
+                        }
+                    ]
+                },
+                "properties": {
+                    "description": "Properties contains the properties of the backup policy.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.BackupPolicyPropertiesResponseDto"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Status contains the status of the backup policy.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.StatusResponseDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updateDate": {
+                    "description": "UpdateDate is the last update date of the resource.",
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "description": "UpdatedBy is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "updatedUser": {
+                    "description": "UpdatedUser is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "uri": {
+                    "description": "URI is the URI of the resource.",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the version of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.FlattenedBackupRestoreListResponseDto": {
+            "type": "object",
+            "properties": {
+                "first": {
+                    "description": "First is the URI of the first page.",
+                    "type": "string"
+                },
+                "last": {
+                    "description": "Last is the URI of the last page.",
+                    "type": "string"
+                },
+                "next": {
+                    "description": "Next is the URI of the next page.",
+                    "type": "string"
+                },
+                "prev": {
+                    "description": "Prev is the URI of the previous page.",
+                    "type": "string"
+                },
+                "self": {
+                    "description": "Self is the URI of the current page.",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total is the total number of restores.",
+                    "type": "integer"
+                },
+                "values": {
+                    "description": "Values is a list of flattened restores.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_backup-plugin_handlers.FlattenedBackupRestoreResponseDto"
+                    }
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.FlattenedBackupRestoreResponseDto": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Category is the category of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.CategoryResponseDto"
+                        }
+                    ]
+                },
+                "createdBy": {
+                    "description": "CreatedBy is the user who created the resource.",
+                    "type": "string"
+                },
+                "createdUser": {
+                    "description": "CreatedUser is the user who created the resource.",
+                    "type": "string"
+                },
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the resource.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the resource.",
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Location is the region where the resource is located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.LocationResponseDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name is the name of the resource.",
+                    "type": "string"
+                },
+                "project": {
+                    "description": "Project is the project where the resource belongs.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.ProjectResponseDto"
+                        }
+                    ]
+                },
+                "properties": {
+                    "description": "Properties contains the properties of the restore.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.BackupRestorePropertiesResponseDto"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Status contains the status of the restore.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.StatusResponseDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updateDate": {
+                    "description": "UpdateDate is the last update date of the resource.",
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "description": "UpdatedBy is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "updatedUser": {
+                    "description": "UpdatedUser is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "uri": {
+                    "description": "URI is the URI of the resource.",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the version of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.FlattenedCreateBackupJobRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for the backup job.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.BackupJobPropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.FlattenedCreateBackupPolicyRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for the backup policy.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.BackupPolicyPropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.FlattenedCreateBackupRestoreRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for the restore.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.BackupRestorePropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.FlattenedUpdateBackupPolicyRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for updating the backup policy.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.BackupPolicyPropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.LinkedResourceResponseDto": {
+            "type": "object",
+            "properties": {
+                "strictCorrelation": {
+                    "description": "StrictCorrelation indicates if the correlation is strict.",
+                    "type": "boolean"
+                },
+                "uri": {
+                    "description": "URI is the URI of the linked resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.LocationDto": {
+            "type": "object",
+            "properties": {
+                "value": {
+                    "description": "Value is the region where the resource will be located.\nAvailable regions at present: ITBG-Bergamo.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.LocationResponseDto": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "description": "City is the city of the region.",
+                    "type": "string"
+                },
+                "code": {
+                    "description": "Code is the code of the region.",
+                    "type": "string"
+                },
+                "country": {
+                    "description": "Country is the country of the region.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of the region.",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value is the value of the region.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.PreviousStatusResponseDto": {
+            "type": "object",
+            "properties": {
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the previous status.",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "State is the previous state of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.ProjectResponseDto": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID is the unique identifier of the project.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.ReferenceDto": {
+            "type": "object",
+            "properties": {
+                "uri": {
+                    "description": "URI is the URI of the referenced resource,\ne.g. /projects/\u003cPROJECT_ID\u003e/providers/Aruba.Storage/blockStorages/\u003cVOLUME_ID\u003e.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.ScheduleDto": {
+            "type": "object",
+            "properties": {
+                "dayOfMonth": {
+                    "description": "DayOfMonth is the day of the monthly backups, between 1 and 28.",
+                    "type": "integer"
+                },
+                "dayOfWeek": {
+                    "description": "DayOfWeek is the day of the weekly backups, e.g. Sunday.",
+                    "type": "string"
+                },
+                "frequency": {
+                    "description": "Frequency is the frequency of the backups.\nAllowed values: Daily, Weekly, Monthly.",
+                    "type": "string"
+                },
+                "time": {
+                    "description": "Time is the time of the day of the backups in UTC, in the HH:MM format, e.g. 02:30.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.StatusResponseDto": {
+            "type": "object",
+            "properties": {
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the status.",
+                    "type": "string"
+                },
+                "disableStatusInfo": {
+                    "description": "DisableStatusInfo contains the information about the disabled status of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_backup-plugin_handlers.DisableStatusInfoResponseDto"
+                        }
+                    ]
+                },
+                "failureReason": {
+                    "description": "FailureReason is the reason of the failure, if any.",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "State is the state of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_backup-plugin_handlers.TypologyResponseDto": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID is the unique identifier of the typology.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of the typology.",
+                    "type": "string"
+                }
+            }
+        }
+    }
+}`
+
+// SwaggerInfo holds exported Swagger Info so clients can modify it
+var SwaggerInfo = &swag.Spec{
+	Version:          "1.0",
+	Host:             "localhost:8080",
+	BasePath:         "/",
+	Schemes:          []string{"http"},
+	Title:            "Aruba Cloud Backup Plugin API for Krateo Operator Generator (KOG)",
+	Description:      "Simple wrapper around Aruba Cloud API to provide consistency of API response for Krateo Operator Generator (KOG)",
+	InfoInstanceName: "swagger",
+	SwaggerTemplate:  docTemplate,
+	LeftDelim:        "{{",
+	RightDelim:       "}}",
+}
+
+func init() {
+	swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+}
@@ -0,0 +1,174 @@
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+)
+
+// BackupJob is the Aruba Cloud backup job resource, holding a backup of a volume or a cloud server
+var BackupJob = handlers.Resource{
+	Name:          "backup job",
+	Plural:        "backup jobs",
+	Path:          "/projects/{projectId}/providers/Aruba.Storage/backupJobs",
+	PathParams:    []handlers.Param{handlers.ProjectIDParam},
+	IDParam:       handlers.Param{Name: "id", Label: "Backup Job ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetBackupJob
+// @Summary Get a backup job from Aruba Cloud
+// @Description Get a backup job from Aruba Cloud using the provided project and backup job details.
+// @Description The progress of the job is reported in properties.progress and its result in properties.result, e.g. Running, Succeeded or Failed.
+// @Description properties.completed is true once the job has ended, successfully or not.
+// @ID get-backup-job
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Backup Job ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedBackupJobResponseDto "Backup job details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/backupJobs/{id} [get]
+func GetBackupJob(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[BackupJobResponseDto](opts, BackupJob)
+}
+
+// PostBackupJob
+// @Summary Create a new backup job on Aruba Cloud
+// @Description Create a new backup job on Aruba Cloud using the provided project details.
+// @Description The job starts an ad-hoc backup of the source. Backup jobs cannot be updated, deleting one deletes its backup.
+// @ID post-backup-job
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param backupJobCreate body FlattenedCreateBackupJobRequestDto true "Backup job creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedBackupJobResponseDto "Backup job details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/backupJobs [post]
+func PostBackupJob(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateBackupJobRequestDto, BackupJobDto, BackupJobResponseDto](opts, BackupJob,
+		func(req FlattenedCreateBackupJobRequestDto) BackupJobDto {
+			return BackupJobDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListBackupJobs
+// @Summary List backup jobs on Aruba Cloud
+// @Description List backup jobs on Aruba Cloud using the provided project details.
+// @ID list-backup-jobs
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedBackupJobListResponseDto "A list of backup jobs"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/backupJobs [get]
+func ListBackupJobs(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[BackupJobResponseDto, FlattenedBackupJobResponseDto](opts, BackupJob)
+}
+
+// DeleteBackupJob
+// @Summary Delete a backup job on Aruba Cloud
+// @Description Delete a backup job on Aruba Cloud using the provided project and backup job details.
+// @Description Deleting a backup job that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-backup-job
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Backup Job ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/backupJobs/{id} [delete]
+func DeleteBackupJob(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, BackupJob)
+}
+
+// Results of backup jobs and restores
+const (
+	ResultPending   = "Pending"
+	ResultRunning   = "Running"
+	ResultSucceeded = "Succeeded"
+	ResultFailed    = "Failed"
+)
+
+// Validate checks the source and the retention of the backup job before it is sent to Aruba Cloud
+func (req FlattenedCreateBackupJobRequestDto) Validate() error {
+	props := req.Properties
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if err := validateTarget("properties.source", props.Source); err != nil {
+		return err
+	}
+	if props.RetentionDays < 0 {
+		return fmt.Errorf("properties.retentionDays must not be negative, got %d", props.RetentionDays)
+	}
+	return nil
+}
+
+// Enrich reports whether the backup job has ended, so that its completion can be awaited on a single status field
+func (resp *BackupJobResponseDto) Enrich() {
+	if resp.Properties != nil {
+		resp.Properties.Completed = completed(resp.Properties.Result)
+	}
+}
+
+// completed reports whether a backup job or a restore with the given result has ended, successfully or not
+func completed(result string) bool {
+	return result == ResultSucceeded || result == ResultFailed
+}
+
+// validateTarget checks that ref references a block storage volume or a cloud server, the resources that can be backed up
+func validateTarget(field string, ref *ReferenceDto) error {
+	if ref == nil || ref.URI == "" {
+		return fmt.Errorf("%s.uri is required", field)
+	}
+	// The URI is /projects/<PROJECT_ID>/providers/<NAMESPACE>/<TYPE>/<ID>
+	segments := strings.Split(ref.URI, "/")
+	if len(segments) != 7 || segments[0] != "" || segments[1] != "projects" || segments[2] == "" || segments[3] != "providers" || segments[6] == "" ||
+		!slices.Contains(backupTargetTypes, segments[4]+"/"+segments[5]) {
+		return fmt.Errorf("%s.uri must reference a block storage volume or a cloud server, got '%s'", field, ref.URI)
+	}
+	return nil
+}
+
+// backupTargetTypes are the provider namespaces and types of the resources that can be backed up
+var backupTargetTypes = []string{"Aruba.Storage/blockStorages", "Aruba.Compute/cloudServers"}
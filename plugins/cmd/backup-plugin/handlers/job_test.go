@@ -0,0 +1,170 @@
+package backup
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// newTestMux serves the backup policy, backup job and restore handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	mux := http.NewServeMux()
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Storage/backupPolicies", PostBackupPolicy(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Storage/backupPolicies/{id}", PutBackupPolicy(opts))
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Storage/backupJobs", ListBackupJobs(opts))
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Storage/backupJobs/{id}", GetBackupJob(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Storage/backupJobs", PostBackupJob(opts))
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Storage/backupJobs/{backupJobId}/restores/{id}", GetBackupRestore(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Storage/backupJobs/{backupJobId}/restores", PostBackupRestore(opts))
+	return mux, calls
+}
+
+// TestBackupJobHandlers tests the unflattening of the backup jobs sent to Aruba Cloud and the completion computed from their result
+func TestBackupJobHandlers(t *testing.T) {
+	const jobsURI = "/projects/p1/providers/Aruba.Storage/backupJobs"
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		upstreamStatus int
+		upstreamBody   string
+		expectedCall   handlertest.Call
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "get of a pending job",
+			method:         http.MethodGet,
+			target:         jobsURI + "/j1?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"metadata":{"id":"j1","name":"nightly"},"status":{"state":"Active"},"properties":{"progress":40,"result":"Pending"}}`,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: jobsURI + "/j1?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":"j1","name":"nightly","properties":{"completed":false,"progress":40,"result":"Pending"},"status":{"state":"Active"}}`,
+		},
+		{
+			name:           "get of a running job",
+			method:         http.MethodGet,
+			target:         jobsURI + "/j1?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"metadata":{"id":"j1","name":"nightly"},"status":{"state":"Active"},"properties":{"progress":40,"result":"Running"}}`,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: jobsURI + "/j1?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":"j1","name":"nightly","properties":{"completed":false,"progress":40,"result":"Running"},"status":{"state":"Active"}}`,
+		},
+		{
+			name:           "get of a succeeded job",
+			method:         http.MethodGet,
+			target:         jobsURI + "/j1?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"metadata":{"id":"j1","name":"nightly"},"status":{"state":"Active"},"properties":{"progress":100,"result":"Succeeded"}}`,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: jobsURI + "/j1?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":"j1","name":"nightly","properties":{"completed":true,"progress":100,"result":"Succeeded"},"status":{"state":"Active"}}`,
+		},
+		{
+			name:           "get of a failed job",
+			method:         http.MethodGet,
+			target:         jobsURI + "/j1?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"metadata":{"id":"j1","name":"nightly"},"status":{"state":"Active"},"properties":{"progress":100,"result":"Failed"}}`,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: jobsURI + "/j1?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":"j1","name":"nightly","properties":{"completed":true,"progress":100,"result":"Failed"},"status":{"state":"Active"}}`,
+		},
+		{
+			name:           "list",
+			method:         http.MethodGet,
+			target:         jobsURI + "?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"total":1,"values":[` + `{"metadata":{"id":"j1","name":"nightly"},"status":{"state":"Active"},"properties":{"progress":100,"result":"Succeeded"}}` + `]}`,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: jobsURI + "?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"total":1,"values":[{"id":"j1","name":"nightly","status":{"state":"Active"},"properties":{"progress":100,"result":"Succeeded","completed":true}}]}`,
+		},
+		{
+			name:           "create",
+			method:         http.MethodPost,
+			target:         jobsURI + "?api-version=1.0",
+			body:           `{"name":"nightly","properties":{"source":{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/v1"},"retentionDays":7}}`,
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   `{"metadata":{"id":"j1","name":"nightly"},"status":{"state":"Active"},"properties":{"progress":40,"result":"Pending"}}`,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: jobsURI + "?api-version=1.0", Body: `{"metadata":{"name":"nightly"},"properties":{"source":{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/v1"},"retentionDays":7}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":"j1","name":"nightly","properties":{"completed":false,"progress":40,"result":"Pending"},"status":{"state":"Active"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.upstreamStatus)
+				w.Write([]byte(tc.upstreamBody))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 1 || (*calls)[0] != tc.expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", tc.expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tc.expectedBody {
+				t.Errorf("expected body '%s', got '%s'", tc.expectedBody, got)
+			}
+		})
+	}
+}
+
+// TestBackupJobHandlers_Validation tests that the backup jobs of resources that cannot be backed up are rejected before calling Aruba Cloud
+func TestBackupJobHandlers_Validation(t *testing.T) {
+	const jobsURI = "/projects/p1/providers/Aruba.Storage/backupJobs?api-version=1.0"
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedDetail string
+	}{
+		{name: "missing source", method: http.MethodPost, target: jobsURI, body: `{"properties":{"retentionDays":7}}`, expectedDetail: "properties.source.uri is required"},
+		{name: "source of another type", method: http.MethodPost, target: jobsURI, body: `{"properties":{"source":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1"}}}`, expectedDetail: `properties.source.uri must reference a block storage volume or a cloud server, got '/projects/p1/providers/Aruba.Network/vpcs/vpc1'`},
+		{name: "source of a subresource of a volume", method: http.MethodPost, target: jobsURI, body: `{"properties":{"source":{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/v1/snapshots/s1"}}}`, expectedDetail: `properties.source.uri must reference a block storage volume or a cloud server, got '/projects/p1/providers/Aruba.Storage/blockStorages/v1/snapshots/s1'`},
+		{name: "source embedding a volume path", method: http.MethodPost, target: jobsURI, body: `{"properties":{"source":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/providers/Aruba.Storage/blockStorages/v1"}}}`, expectedDetail: `properties.source.uri must reference a block storage volume or a cloud server, got '/projects/p1/providers/Aruba.Network/vpcs/vpc1/providers/Aruba.Storage/blockStorages/v1'`},
+		{name: "source without ID", method: http.MethodPost, target: jobsURI, body: `{"properties":{"source":{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/"}}}`, expectedDetail: `properties.source.uri must reference a block storage volume or a cloud server, got '/projects/p1/providers/Aruba.Compute/cloudServers/'`},
+		{name: "source without project", method: http.MethodPost, target: jobsURI, body: `{"properties":{"source":{"uri":"/projects//providers/Aruba.Compute/cloudServers/cs1"}}}`, expectedDetail: `properties.source.uri must reference a block storage volume or a cloud server, got '/projects//providers/Aruba.Compute/cloudServers/cs1'`},
+		{name: "relative source", method: http.MethodPost, target: jobsURI, body: `{"properties":{"source":{"uri":"projects/p1/providers/Aruba.Compute/cloudServers/cs1"}}}`, expectedDetail: `properties.source.uri must reference a block storage volume or a cloud server, got 'projects/p1/providers/Aruba.Compute/cloudServers/cs1'`},
+		{name: "negative retention", method: http.MethodPost, target: jobsURI, body: `{"properties":{"source":{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/cs1"},"retentionDays":-1}}`, expectedDetail: "properties.retentionDays must not be negative, got -1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 0 {
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			}
+			if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+				t.Fatalf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+			}
+			var problem handlers.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
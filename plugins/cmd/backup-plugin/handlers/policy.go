@@ -0,0 +1,216 @@
+package backup
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// BackupPolicy is the Aruba Cloud backup policy resource, backing up volumes and cloud servers on a schedule
+var BackupPolicy = handlers.Resource{
+	Name:          "backup policy",
+	Plural:        "backup policies",
+	Path:          "/projects/{projectId}/providers/Aruba.Storage/backupPolicies",
+	PathParams:    []handlers.Param{handlers.ProjectIDParam},
+	IDParam:       handlers.Param{Name: "id", Label: "Backup Policy ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetBackupPolicy
+// @Summary Get a backup policy from Aruba Cloud
+// @Description Get a backup policy from Aruba Cloud using the provided project and backup policy details.
+// @ID get-backup-policy
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Backup Policy ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedBackupPolicyResponseDto "Backup policy details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/backupPolicies/{id} [get]
+func GetBackupPolicy(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[BackupPolicyResponseDto](opts, BackupPolicy)
+}
+
+// PostBackupPolicy
+// @Summary Create a new backup policy on Aruba Cloud
+// @Description Create a new backup policy on Aruba Cloud using the provided project details.
+// @Description Aruba Cloud starts a backup job for every target of the policy at each run of its schedule.
+// @ID post-backup-policy
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param backupPolicyCreate body FlattenedCreateBackupPolicyRequestDto true "Backup policy creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedBackupPolicyResponseDto "Backup policy details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/backupPolicies [post]
+func PostBackupPolicy(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateBackupPolicyRequestDto, BackupPolicyDto, BackupPolicyResponseDto](opts, BackupPolicy,
+		func(req FlattenedCreateBackupPolicyRequestDto) BackupPolicyDto {
+			return BackupPolicyDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutBackupPolicy
+// @Summary Update a backup policy on Aruba Cloud
+// @Description Update a backup policy on Aruba Cloud using the provided project and backup policy details.
+// @ID put-backup-policy
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Backup Policy ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param backupPolicyUpdate body FlattenedUpdateBackupPolicyRequestDto true "Backup policy update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedBackupPolicyResponseDto "Backup policy details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/backupPolicies/{id} [put]
+func PutBackupPolicy(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateBackupPolicyRequestDto, BackupPolicyDto, BackupPolicyResponseDto](opts, BackupPolicy,
+		func(req FlattenedUpdateBackupPolicyRequestDto) BackupPolicyDto {
+			return BackupPolicyDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListBackupPolicies
+// @Summary List backup policies on Aruba Cloud
+// @Description List backup policies on Aruba Cloud using the provided project details.
+// @ID list-backup-policies
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedBackupPolicyListResponseDto "A list of backup policies"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/backupPolicies [get]
+func ListBackupPolicies(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[BackupPolicyResponseDto, FlattenedBackupPolicyResponseDto](opts, BackupPolicy)
+}
+
+// DeleteBackupPolicy
+// @Summary Delete a backup policy on Aruba Cloud
+// @Description Delete a backup policy on Aruba Cloud using the provided project and backup policy details.
+// @Description Deleting a backup policy that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-backup-policy
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Backup Policy ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/backupPolicies/{id} [delete]
+func DeleteBackupPolicy(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, BackupPolicy)
+}
+
+// Frequencies of a backup schedule
+const (
+	FrequencyDaily   = "Daily"
+	FrequencyWeekly  = "Weekly"
+	FrequencyMonthly = "Monthly"
+)
+
+// Validate checks the schedule, the retention and the targets of the backup policy before it is sent to Aruba Cloud
+func (req FlattenedCreateBackupPolicyRequestDto) Validate() error {
+	return validatePolicy(req.Properties)
+}
+
+// Validate checks the schedule, the retention and the targets of the backup policy before it is sent to Aruba Cloud
+func (req FlattenedUpdateBackupPolicyRequestDto) Validate() error {
+	return validatePolicy(req.Properties)
+}
+
+func validatePolicy(props *BackupPolicyPropertiesDto) error {
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if props.Schedule == nil {
+		return errors.New("properties.schedule is required")
+	}
+	if err := validateSchedule(props.Schedule); err != nil {
+		return err
+	}
+	if props.RetentionDays < 1 {
+		return fmt.Errorf("properties.retentionDays must be at least 1, got %d", props.RetentionDays)
+	}
+	if len(props.Targets) == 0 {
+		return errors.New("properties.targets must contain at least one volume or cloud server")
+	}
+	for i := range props.Targets {
+		if err := validateTarget(fmt.Sprintf("properties.targets[%d]", i), &props.Targets[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSchedule checks the time of the schedule and the day it needs for its frequency
+func validateSchedule(schedule *ScheduleDto) error {
+	if err := validation.OneOf("properties.schedule.frequency", schedule.Frequency, FrequencyDaily, FrequencyWeekly, FrequencyMonthly); err != nil {
+		return err
+	}
+	if _, err := time.Parse("15:04", schedule.Time); err != nil {
+		return fmt.Errorf("properties.schedule.time must be a time of the day in the HH:MM format, got '%s'", schedule.Time)
+	}
+	switch schedule.Frequency {
+	case FrequencyWeekly:
+		days := []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+		if err := validation.OneOf("properties.schedule.dayOfWeek", schedule.DayOfWeek, days...); err != nil {
+			return err
+		}
+	case FrequencyMonthly:
+		// Days after the 28th do not exist in every month
+		if schedule.DayOfMonth < 1 || schedule.DayOfMonth > 28 {
+			return fmt.Errorf("properties.schedule.dayOfMonth must be between 1 and 28, got %d", schedule.DayOfMonth)
+		}
+	}
+	return nil
+}
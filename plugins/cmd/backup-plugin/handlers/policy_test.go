@@ -0,0 +1,119 @@
+package backup
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// TestBackupPolicyHandlers tests the unflattening of the backup policies sent to Aruba Cloud
+func TestBackupPolicyHandlers(t *testing.T) {
+	const policiesURI = "/projects/p1/providers/Aruba.Storage/backupPolicies"
+	policy := `{"metadata":{"id":"bp1","name":"daily"},"status":{"state":"Active"},"properties":{"retentionDays":14}}`
+	flattened := `{"id":"bp1","name":"daily","properties":{"retentionDays":14},"status":{"state":"Active"}}`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		upstreamStatus int
+		upstreamBody   string
+		expectedCall   handlertest.Call
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "create",
+			method:         http.MethodPost,
+			target:         policiesURI + "?api-version=1.0",
+			body:           `{"name":"daily","properties":{"schedule":{"frequency":"Weekly","time":"02:30","dayOfWeek":"Sunday"},"retentionDays":14,"targets":[{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/v1"},{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/cs1"}]}}`,
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   policy,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: policiesURI + "?api-version=1.0", Body: `{"metadata":{"name":"daily"},"properties":{"schedule":{"frequency":"Weekly","time":"02:30","dayOfWeek":"Sunday"},"retentionDays":14,"targets":[{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/v1"},{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/cs1"}]}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "update",
+			method:         http.MethodPut,
+			target:         policiesURI + "/bp1?api-version=1.0",
+			body:           `{"name":"daily","properties":{"schedule":{"frequency":"Weekly","time":"02:30","dayOfWeek":"Sunday"},"retentionDays":14,"targets":[{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/v1"},{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/cs1"}]}}`,
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   policy,
+			expectedCall:   handlertest.Call{Method: http.MethodPut, URI: policiesURI + "/bp1?api-version=1.0", Body: `{"metadata":{"name":"daily"},"properties":{"schedule":{"frequency":"Weekly","time":"02:30","dayOfWeek":"Sunday"},"retentionDays":14,"targets":[{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/v1"},{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/cs1"}]}}`},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.upstreamStatus)
+				w.Write([]byte(tc.upstreamBody))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 1 || (*calls)[0] != tc.expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", tc.expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tc.expectedBody {
+				t.Errorf("expected body '%s', got '%s'", tc.expectedBody, got)
+			}
+		})
+	}
+}
+
+// TestBackupPolicyHandlers_Validation tests that the backup policies with an invalid schedule or targets are rejected before calling Aruba Cloud
+func TestBackupPolicyHandlers_Validation(t *testing.T) {
+	const policiesURI = "/projects/p1/providers/Aruba.Storage/backupPolicies?api-version=1.0"
+	const policyURI = "/projects/p1/providers/Aruba.Storage/backupPolicies/bp1?api-version=1.0"
+	target := `"targets":[{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/v1"}]`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedDetail string
+	}{
+		{name: "unknown frequency", method: http.MethodPost, target: policiesURI, body: `{"properties":{"schedule":{"frequency":"Hourly","time":"02:30"},"retentionDays":7,` + target + `}}`, expectedDetail: `properties.schedule.frequency must be one of Daily, Weekly, Monthly, got 'Hourly'`},
+		{name: "invalid time", method: http.MethodPost, target: policiesURI, body: `{"properties":{"schedule":{"frequency":"Daily","time":"2:30am"},"retentionDays":7,` + target + `}}`, expectedDetail: `properties.schedule.time must be a time of the day in the HH:MM format, got '2:30am'`},
+		{name: "weekly without day", method: http.MethodPost, target: policiesURI, body: `{"properties":{"schedule":{"frequency":"Weekly","time":"02:30"},"retentionDays":7,` + target + `}}`, expectedDetail: `properties.schedule.dayOfWeek must be one of Monday, Tuesday, Wednesday, Thursday, Friday, Saturday, Sunday, got ''`},
+		{name: "monthly on the 31st", method: http.MethodPost, target: policiesURI, body: `{"properties":{"schedule":{"frequency":"Monthly","time":"02:30","dayOfMonth":31},"retentionDays":7,` + target + `}}`, expectedDetail: "properties.schedule.dayOfMonth must be between 1 and 28, got 31"},
+		{name: "missing retention", method: http.MethodPost, target: policiesURI, body: `{"properties":{"schedule":{"frequency":"Daily","time":"02:30"},` + target + `}}`, expectedDetail: "properties.retentionDays must be at least 1, got 0"},
+		{name: "no targets", method: http.MethodPost, target: policiesURI, body: `{"properties":{"schedule":{"frequency":"Daily","time":"02:30"},"retentionDays":7}}`, expectedDetail: "properties.targets must contain at least one volume or cloud server"},
+		{name: "target of another type on update", method: http.MethodPut, target: policyURI, body: `{"properties":{"schedule":{"frequency":"Daily","time":"02:30"},"retentionDays":7,"targets":[{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/v1"},{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1"}]}}`, expectedDetail: `properties.targets[1].uri must reference a block storage volume or a cloud server, got '/projects/p1/providers/Aruba.Network/vpcs/vpc1'`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 0 {
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			}
+			if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+				t.Fatalf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+			}
+			var problem handlers.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package backup
+
+import (
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+)
+
+// BackupRestore is the Aruba Cloud restore resource of a backup job, restoring its backup on a volume or a cloud server
+var BackupRestore = handlers.Resource{
+	Name:   "restore",
+	Plural: "restores",
+	Path:   "/projects/{projectId}/providers/Aruba.Storage/backupJobs/{backupJobId}/restores",
+	PathParams: []handlers.Param{
+		handlers.ProjectIDParam,
+		{Name: "backupJobId", Label: "Backup Job ID"},
+	},
+	IDParam:       handlers.Param{Name: "id", Label: "Restore ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetBackupRestore
+// @Summary Get a restore from Aruba Cloud
+// @Description Get a restore from Aruba Cloud using the provided project, backup job and restore details.
+// @Description The progress of the restore is reported in properties.progress and its result in properties.result, e.g. Running, Succeeded or Failed.
+// @Description properties.completed is true once the restore has ended, successfully or not.
+// @ID get-backup-restore
+// @Param projectId path string true "Project ID"
+// @Param backupJobId path string true "Backup Job ID"
+// @Param id path string true "Restore ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedBackupRestoreResponseDto "Restore details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/backupJobs/{backupJobId}/restores/{id} [get]
+func GetBackupRestore(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[BackupRestoreResponseDto](opts, BackupRestore)
+}
+
+// PostBackupRestore
+// @Summary Create a new restore on Aruba Cloud
+// @Description Create a new restore on Aruba Cloud using the provided project and backup job details.
+// @Description The backup is restored on the source of the backup job, unless properties.target references another volume or cloud server.
+// @Description Restores cannot be updated nor deleted once requested.
+// @ID post-backup-restore
+// @Param projectId path string true "Project ID"
+// @Param backupJobId path string true "Backup Job ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param backupRestoreCreate body FlattenedCreateBackupRestoreRequestDto true "Restore creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedBackupRestoreResponseDto "Restore details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/backupJobs/{backupJobId}/restores [post]
+func PostBackupRestore(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateBackupRestoreRequestDto, BackupRestoreDto, BackupRestoreResponseDto](opts, BackupRestore,
+		func(req FlattenedCreateBackupRestoreRequestDto) BackupRestoreDto {
+			return BackupRestoreDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListBackupRestores
+// @Summary List restores on Aruba Cloud
+// @Description List restores on Aruba Cloud using the provided project and backup job details.
+// @ID list-backup-restores
+// @Param projectId path string true "Project ID"
+// @Param backupJobId path string true "Backup Job ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedBackupRestoreListResponseDto "A list of restores"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/backupJobs/{backupJobId}/restores [get]
+func ListBackupRestores(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[BackupRestoreResponseDto, FlattenedBackupRestoreResponseDto](opts, BackupRestore)
+}
+
+// Validate checks the target of the restore, when it is not the source of the backup, before it is sent to Aruba Cloud
+func (req FlattenedCreateBackupRestoreRequestDto) Validate() error {
+	if req.Properties == nil || req.Properties.Target == nil {
+		return nil
+	}
+	return validateTarget("properties.target", req.Properties.Target)
+}
+
+// Enrich reports whether the restore has ended, so that its completion can be awaited on a single status field
+func (resp *BackupRestoreResponseDto) Enrich() {
+	if resp.Properties != nil {
+		resp.Properties.Completed = completed(resp.Properties.Result)
+	}
+}
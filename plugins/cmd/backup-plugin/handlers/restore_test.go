@@ -0,0 +1,140 @@
+package backup
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// TestBackupRestoreHandlers tests the unflattening of the restores sent to Aruba Cloud and the completion computed from their result
+func TestBackupRestoreHandlers(t *testing.T) {
+	const restoresURI = "/projects/p1/providers/Aruba.Storage/backupJobs/j1/restores"
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		upstreamStatus int
+		upstreamBody   string
+		expectedCall   handlertest.Call
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "get of a running restore",
+			method:         http.MethodGet,
+			target:         restoresURI + "/r1?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"metadata":{"id":"r1","name":"rollback"},"properties":{"result":"Running"}}`,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: restoresURI + "/r1?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":"r1","name":"rollback","properties":{"completed":false,"result":"Running"}}`,
+		},
+		{
+			name:           "get of a succeeded restore",
+			method:         http.MethodGet,
+			target:         restoresURI + "/r1?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"metadata":{"id":"r1","name":"rollback"},"properties":{"result":"Succeeded"}}`,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: restoresURI + "/r1?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":"r1","name":"rollback","properties":{"completed":true,"result":"Succeeded"}}`,
+		},
+		{
+			name:           "get of a failed restore",
+			method:         http.MethodGet,
+			target:         restoresURI + "/r1?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"metadata":{"id":"r1","name":"rollback"},"properties":{"result":"Failed"}}`,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: restoresURI + "/r1?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":"r1","name":"rollback","properties":{"completed":true,"result":"Failed"}}`,
+		},
+		{
+			name:           "create on the source of the backup",
+			method:         http.MethodPost,
+			target:         restoresURI + "?api-version=1.0",
+			body:           `{"name":"rollback"}`,
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   `{"metadata":{"id":"r1","name":"rollback"},"properties":{"result":"Pending"}}`,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: restoresURI + "?api-version=1.0", Body: `{"metadata":{"name":"rollback"}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":"r1","name":"rollback","properties":{"completed":false,"result":"Pending"}}`,
+		},
+		{
+			name:           "create on another volume",
+			method:         http.MethodPost,
+			target:         restoresURI + "?api-version=1.0",
+			body:           `{"name":"rollback","properties":{"target":{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/v2"}}}`,
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   `{"metadata":{"id":"r1","name":"rollback"},"properties":{"result":"Pending"}}`,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: restoresURI + "?api-version=1.0", Body: `{"metadata":{"name":"rollback"},"properties":{"target":{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/v2"}}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":"r1","name":"rollback","properties":{"completed":false,"result":"Pending"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.upstreamStatus)
+				w.Write([]byte(tc.upstreamBody))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 1 || (*calls)[0] != tc.expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", tc.expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tc.expectedBody {
+				t.Errorf("expected body '%s', got '%s'", tc.expectedBody, got)
+			}
+		})
+	}
+}
+
+// TestBackupRestoreHandlers_Validation tests that the restores on resources that cannot be backed up are rejected before calling Aruba Cloud
+func TestBackupRestoreHandlers_Validation(t *testing.T) {
+	const restoresURI = "/projects/p1/providers/Aruba.Storage/backupJobs/j1/restores?api-version=1.0"
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedDetail string
+	}{
+		{name: "target of another type", method: http.MethodPost, target: restoresURI, body: `{"properties":{"target":{"uri":"/projects/p1/providers/Aruba.Network/elasticIps/eip1"}}}`, expectedDetail: `properties.target.uri must reference a block storage volume or a cloud server, got '/projects/p1/providers/Aruba.Network/elasticIps/eip1'`},
+		{name: "target of a subresource of a cloud server", method: http.MethodPost, target: restoresURI, body: `{"properties":{"target":{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/cs1/volumes/v1"}}}`, expectedDetail: `properties.target.uri must reference a block storage volume or a cloud server, got '/projects/p1/providers/Aruba.Compute/cloudServers/cs1/volumes/v1'`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 0 {
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			}
+			if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+				t.Fatalf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+			}
+			var problem handlers.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
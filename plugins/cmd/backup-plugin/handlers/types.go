@@ -0,0 +1,446 @@
+package backup
+
+type BackupPolicyDto struct {
+	Metadata   *MetadataDto               `json:"metadata,omitempty"`
+	Properties *BackupPolicyPropertiesDto `json:"properties,omitempty"`
+}
+
+type BackupJobDto struct {
+	Metadata   *MetadataDto            `json:"metadata,omitempty"`
+	Properties *BackupJobPropertiesDto `json:"properties,omitempty"`
+}
+
+type BackupRestoreDto struct {
+	Metadata   *MetadataDto                `json:"metadata,omitempty"`
+	Properties *BackupRestorePropertiesDto `json:"properties,omitempty"`
+}
+
+type MetadataDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+}
+
+type LocationDto struct {
+	// Value is the region where the resource will be located.
+	// Available regions at present: ITBG-Bergamo.
+	Value string `json:"value,omitempty"`
+}
+
+type BackupPolicyPropertiesDto struct {
+	// Schedule is the time at which the targets are backed up.
+	Schedule *ScheduleDto `json:"schedule,omitempty"`
+	// RetentionDays is the number of days the backups are kept, at least 1.
+	RetentionDays int32 `json:"retentionDays,omitempty"`
+	// Targets are the volumes and cloud servers backed up,
+	// e.g. /projects/<PROJECT_ID>/providers/Aruba.Storage/blockStorages/<VOLUME_ID>.
+	Targets []ReferenceDto `json:"targets,omitempty"`
+}
+
+type ScheduleDto struct {
+	// Frequency is the frequency of the backups.
+	// Allowed values: Daily, Weekly, Monthly.
+	Frequency string `json:"frequency,omitempty"`
+	// Time is the time of the day of the backups in UTC, in the HH:MM format, e.g. 02:30.
+	Time string `json:"time,omitempty"`
+	// DayOfWeek is the day of the weekly backups, e.g. Sunday.
+	DayOfWeek string `json:"dayOfWeek,omitempty"`
+	// DayOfMonth is the day of the monthly backups, between 1 and 28.
+	DayOfMonth int32 `json:"dayOfMonth,omitempty"`
+}
+
+type BackupJobPropertiesDto struct {
+	// Source is the volume or cloud server backed up,
+	// e.g. /projects/<PROJECT_ID>/providers/Aruba.Compute/cloudServers/<CLOUD_SERVER_ID>.
+	Source *ReferenceDto `json:"source,omitempty"`
+	// RetentionDays is the number of days the backup is kept, forever if empty.
+	RetentionDays int32 `json:"retentionDays,omitempty"`
+}
+
+type BackupRestorePropertiesDto struct {
+	// Target is the volume or cloud server the backup is restored on, the source of the backup job if empty.
+	Target *ReferenceDto `json:"target,omitempty"`
+}
+
+type ReferenceDto struct {
+	// URI is the URI of the referenced resource,
+	// e.g. /projects/<PROJECT_ID>/providers/Aruba.Storage/blockStorages/<VOLUME_ID>.
+	URI string `json:"uri,omitempty"`
+}
+
+type BackupPolicyResponseDto struct {
+	Metadata   *MetadataResponseDto               `json:"metadata,omitempty"`
+	Status     *StatusResponseDto                 `json:"status,omitempty"`
+	Properties *BackupPolicyPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type BackupJobResponseDto struct {
+	Metadata   *MetadataResponseDto            `json:"metadata,omitempty"`
+	Status     *StatusResponseDto              `json:"status,omitempty"`
+	Properties *BackupJobPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type BackupRestoreResponseDto struct {
+	Metadata   *MetadataResponseDto                `json:"metadata,omitempty"`
+	Status     *StatusResponseDto                  `json:"status,omitempty"`
+	Properties *BackupRestorePropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type MetadataResponseDto struct {
+	ID           string               `json:"id,omitempty"`
+	URI          string               `json:"uri,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	Location     *LocationResponseDto `json:"location,omitempty"`
+	Project      *ProjectResponseDto  `json:"project,omitempty"`
+	Tags         []string             `json:"tags,omitempty"`
+	Category     *CategoryResponseDto `json:"category,omitempty"`
+	CreationDate string               `json:"creationDate,omitempty"`
+	CreatedBy    string               `json:"createdBy,omitempty"`
+	UpdateDate   string               `json:"updateDate,omitempty"`
+	UpdatedBy    string               `json:"updatedBy,omitempty"`
+	Version      string               `json:"version,omitempty"`
+	CreatedUser  string               `json:"createdUser,omitempty"`
+	UpdatedUser  string               `json:"updatedUser,omitempty"`
+}
+
+type LocationResponseDto struct {
+	// Code is the code of the region.
+	Code string `json:"code,omitempty"`
+	// Country is the country of the region.
+	Country string `json:"country,omitempty"`
+	// City is the city of the region.
+	City string `json:"city,omitempty"`
+	// Name is the name of the region.
+	Name string `json:"name,omitempty"`
+	// Value is the value of the region.
+	Value string `json:"value,omitempty"`
+}
+
+type ProjectResponseDto struct {
+	// ID is the unique identifier of the project.
+	ID string `json:"id,omitempty"`
+}
+
+type CategoryResponseDto struct {
+	// Name is the name of the category.
+	Name string `json:"name,omitempty"`
+	// Provider is the provider of the category.
+	Provider string `json:"provider,omitempty"`
+	// Typology is the typology of the category.
+	Typology *TypologyResponseDto `json:"typology,omitempty"`
+}
+
+type TypologyResponseDto struct {
+	// ID is the unique identifier of the typology.
+	ID string `json:"id,omitempty"`
+	// Name is the name of the typology.
+	Name string `json:"name,omitempty"`
+}
+
+type StatusResponseDto struct {
+	// State is the state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the status.
+	CreationDate string `json:"creationDate,omitempty"`
+	// DisableStatusInfo contains the information about the disabled status of the resource.
+	DisableStatusInfo *DisableStatusInfoResponseDto `json:"disableStatusInfo,omitempty"`
+	// FailureReason is the reason of the failure, if any.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+type DisableStatusInfoResponseDto struct {
+	// IsDisabled indicates if the resource is disabled.
+	IsDisabled bool `json:"isDisabled,omitempty"`
+	// Reasons is a list of reasons for the disabled status.
+	Reasons []string `json:"reasons,omitempty"`
+	// PreviousStatus is the previous status of the resource.
+	PreviousStatus *PreviousStatusResponseDto `json:"previousStatus,omitempty"`
+}
+
+type PreviousStatusResponseDto struct {
+	// State is the previous state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the previous status.
+	CreationDate string `json:"creationDate,omitempty"`
+}
+
+type BackupPolicyPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the policy, e.g. its targets.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Schedule is the time at which the targets are backed up.
+	Schedule *ScheduleDto `json:"schedule,omitempty"`
+	// RetentionDays is the number of days the backups are kept.
+	RetentionDays int32 `json:"retentionDays,omitempty"`
+	// Targets are the volumes and cloud servers backed up.
+	Targets []ReferenceDto `json:"targets,omitempty"`
+	// NextRun is the time of the next run of the policy, in RFC 3339 format.
+	NextRun string `json:"nextRun,omitempty"`
+}
+
+type BackupJobPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the job, e.g. its source.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Source is the volume or cloud server backed up.
+	Source *ReferenceDto `json:"source,omitempty"`
+	// Policy is the backup policy that started the job, empty for ad-hoc jobs.
+	Policy *ReferenceDto `json:"policy,omitempty"`
+	// RetentionDays is the number of days the backup is kept.
+	RetentionDays int32 `json:"retentionDays,omitempty"`
+	// Progress is the percentage of the backup completed, from 0 to 100.
+	Progress int32 `json:"progress,omitempty"`
+	// Result is the result of the job, e.g. Pending, Running, Succeeded or Failed.
+	Result string `json:"result,omitempty"`
+	// Completed is true once the job has ended, successfully or not. It is computed by the plugin from Result.
+	Completed bool `json:"completed"`
+	// SizeGb is the size of the backup.
+	SizeGb int32 `json:"sizeGb,omitempty"`
+	// StartedAt is the start time of the job, in RFC 3339 format.
+	StartedAt string `json:"startedAt,omitempty"`
+	// CompletedAt is the end time of the job, in RFC 3339 format.
+	CompletedAt string `json:"completedAt,omitempty"`
+}
+
+type BackupRestorePropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the restore, e.g. its target.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Target is the volume or cloud server the backup is restored on.
+	Target *ReferenceDto `json:"target,omitempty"`
+	// Progress is the percentage of the restore completed, from 0 to 100.
+	Progress int32 `json:"progress,omitempty"`
+	// Result is the result of the restore, e.g. Pending, Running, Succeeded or Failed.
+	Result string `json:"result,omitempty"`
+	// Completed is true once the restore has ended, successfully or not. It is computed by the plugin from Result.
+	Completed bool `json:"completed"`
+	// StartedAt is the start time of the restore, in RFC 3339 format.
+	StartedAt string `json:"startedAt,omitempty"`
+	// CompletedAt is the end time of the restore, in RFC 3339 format.
+	CompletedAt string `json:"completedAt,omitempty"`
+}
+
+type LinkedResourceResponseDto struct {
+	// URI is the URI of the linked resource.
+	URI string `json:"uri,omitempty"`
+	// StrictCorrelation indicates if the correlation is strict.
+	StrictCorrelation bool `json:"strictCorrelation,omitempty"`
+}
+
+// --------------------------------------------------------------------------
+// Flattened types
+// --------------------------------------------------------------------------
+
+// FlattenedCreateBackupPolicyRequestDto is the flattened request body for creating a backup policy.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateBackupPolicyRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the backup policy.
+	Properties *BackupPolicyPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateBackupPolicyRequestDto is the flattened request body for updating a backup policy.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateBackupPolicyRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the backup policy.
+	Properties *BackupPolicyPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedBackupPolicyResponseDto is the flattened response body for a single backup policy.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedBackupPolicyResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the backup policy.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the backup policy.
+	Properties *BackupPolicyPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedBackupPolicyListResponseDto struct {
+	// Total is the total number of backup policies.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened backup policies.
+	Values []FlattenedBackupPolicyResponseDto `json:"values,omitempty"`
+}
+
+// FlattenedCreateBackupJobRequestDto is the flattened request body for creating a backup job.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateBackupJobRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the backup job.
+	Properties *BackupJobPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedBackupJobResponseDto is the flattened response body for a single backup job.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedBackupJobResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the backup job.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the backup job.
+	Properties *BackupJobPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedBackupJobListResponseDto struct {
+	// Total is the total number of backup jobs.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened backup jobs.
+	Values []FlattenedBackupJobResponseDto `json:"values,omitempty"`
+}
+
+// FlattenedCreateBackupRestoreRequestDto is the flattened request body for creating a restore.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateBackupRestoreRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the restore.
+	Properties *BackupRestorePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedBackupRestoreResponseDto is the flattened response body for a single restore.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedBackupRestoreResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the restore.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the restore.
+	Properties *BackupRestorePropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedBackupRestoreListResponseDto struct {
+	// Total is the total number of restores.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened restores.
+	Values []FlattenedBackupRestoreResponseDto `json:"values,omitempty"`
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	backup "github.com/krateoplatformops/arubacloud-provider-kog/backup-plugin/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/health"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/server"
+	httpSwagger "github.com/swaggo/http-swagger"
+)
+
+// @title           Aruba Cloud Backup Plugin API for Krateo Operator Generator (KOG)
+// @version         1.0
+// @description     Simple wrapper around Aruba Cloud API to provide consistency of API response for Krateo Operator Generator (KOG)
+// @termsOfService  http://swagger.io/terms/
+// @contact.name    Krateo Support
+// @contact.url     https://krateo.io
+// @contact.email   contact@krateoplatformops.io
+// @license.name    Apache 2.0
+// @license.url     http://www.apache.org/licenses/LICENSE-2.0.html
+// @host            localhost:8080
+// @BasePath        /
+// @schemes         http
+func main() {
+	srv := server.New()
+
+	opts := handlers.HandlerOptions{
+		Log:      srv.Logger(),
+		Client:   srv.HTTPClient(),
+		BaseURL:  srv.BaseURL(),
+		Auth:     srv.TokenProvider(),
+		Timeouts: srv.UpstreamTimeouts(),
+	}
+
+	// Backup policy
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Storage/backupPolicies", backup.PostBackupPolicy(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Storage/backupPolicies", backup.ListBackupPolicies(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Storage/backupPolicies/{id}", backup.GetBackupPolicy(opts))
+	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Storage/backupPolicies/{id}", backup.PutBackupPolicy(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Storage/backupPolicies/{id}", backup.DeleteBackupPolicy(opts))
+
+	// Backup job
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Storage/backupJobs", backup.PostBackupJob(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Storage/backupJobs", backup.ListBackupJobs(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Storage/backupJobs/{id}", backup.GetBackupJob(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Storage/backupJobs/{id}", backup.DeleteBackupJob(opts))
+
+	// Restore
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Storage/backupJobs/{backupJobId}/restores", backup.PostBackupRestore(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Storage/backupJobs/{backupJobId}/restores", backup.ListBackupRestores(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Storage/backupJobs/{backupJobId}/restores/{id}", backup.GetBackupRestore(opts))
+
+	// Swagger UI
+	srv.Mux().Handle("/swagger/", httpSwagger.WrapHandler)
+
+	// Kubernetes health check endpoints
+	srv.Mux().HandleFunc("GET /healthz", health.LivenessHandler(srv.Healthy()))
+	srv.Mux().HandleFunc("GET /readyz", srv.ReadinessHandler())
+
+	srv.Run()
+}
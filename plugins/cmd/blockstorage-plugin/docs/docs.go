@@ -0,0 +1,1573 @@
+// Package docs Code generated by swaggo/swag. DO NOT EDIT
+package docs
+
+import "github.com/swaggo/swag"
+
+const docTemplate = `{
+    "schemes": {{ marshal .Schemes }},
+    "swagger": "2.0",
+    "info": {
+        "description": "{{escape .Description}}",
+        "title": "{{.Title}}",
+        "termsOfService": "http://swagger.io/terms/",
+        "contact": {
+            "name": "Krateo Support",
+            "url": "https://krateo.io",
+            "email": "contact@krateoplatformops.io"
+        },
+        "license": {
+            "name": "Apache 2.0",
+            "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+        },
+        "version": "{{.Version}}"
+    },
+    "host": "{{.Host}}",
+    "basePath": "{{.BasePath}}",
+    "paths": {
+        "/projects/{projectId}/providers/Aruba.Storage/blockStorages": {
+            "get": {
+                "description": "List volumes on Aruba Cloud using the provided project details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List volumes on Aruba Cloud",
+                "operationId": "list-volumes",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort expression",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Projection expression",
+                        "name": "projection",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A list of volumes",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.FlattenedVolumeListResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new volume on Aruba Cloud using the provided project details.\nA volume is restored from a snapshot by referencing it in properties.snapshot.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create a new volume on Aruba Cloud",
+                "operationId": "post-volume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Volume creation request body",
+                        "name": "volumeCreate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.FlattenedCreateVolumeRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Volume details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.FlattenedVolumeResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Storage/blockStorages/{id}": {
+            "get": {
+                "description": "Get a volume from Aruba Cloud using the provided project and volume details.\nThe state of the volume is reported in status.state, e.g. InCreation while it is being provisioned and Active once it can be used.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a volume from Aruba Cloud",
+                "operationId": "get-volume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Volume ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag",
+                        "name": "ignoreDeletedStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Volume details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.FlattenedVolumeResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a volume on Aruba Cloud using the provided project and volume details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Update a volume on Aruba Cloud",
+                "operationId": "put-volume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Volume ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Volume update request body",
+                        "name": "volumeUpdate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.FlattenedUpdateVolumeRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Volume details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.FlattenedVolumeResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a volume on Aruba Cloud using the provided project and volume details.\nDeleting a volume that does not exist or is already in 'Deleted' state is considered successful.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete a volume on Aruba Cloud",
+                "operationId": "delete-volume",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Volume ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Storage/snapshots": {
+            "get": {
+                "description": "List snapshots on Aruba Cloud using the provided project details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List snapshots on Aruba Cloud",
+                "operationId": "list-snapshots",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort expression",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Projection expression",
+                        "name": "projection",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A list of snapshots",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.FlattenedSnapshotListResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new snapshot on Aruba Cloud using the provided project details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create a new snapshot on Aruba Cloud",
+                "operationId": "post-snapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Snapshot creation request body",
+                        "name": "snapshotCreate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.FlattenedCreateSnapshotRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Snapshot details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.FlattenedSnapshotResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Storage/snapshots/{id}": {
+            "get": {
+                "description": "Get a snapshot from Aruba Cloud using the provided project and snapshot details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a snapshot from Aruba Cloud",
+                "operationId": "get-snapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Snapshot ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag",
+                        "name": "ignoreDeletedStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Snapshot details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.FlattenedSnapshotResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a snapshot on Aruba Cloud using the provided project and snapshot details.\nOnly the name, location and tags of a snapshot can be updated.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Update a snapshot on Aruba Cloud",
+                "operationId": "put-snapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Snapshot ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Snapshot update request body",
+                        "name": "snapshotUpdate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.FlattenedUpdateSnapshotRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Snapshot details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.FlattenedSnapshotResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a snapshot on Aruba Cloud using the provided project and snapshot details.\nDeleting a snapshot that does not exist or is already in 'Deleted' state is considered successful.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete a snapshot on Aruba Cloud",
+                "operationId": "delete-snapshot",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Snapshot ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "ProblemDetails": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "description": "Detail is a human-readable explanation of the error.",
+                    "type": "string"
+                },
+                "instance": {
+                    "description": "Instance is the path of the request that caused the error.",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status is the HTTP status code of the response.",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "Title is a short summary of the error type.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type is a URI identifying the error type.",
+                    "type": "string"
+                },
+                "upstream": {
+                    "description": "Upstream is the original error body returned by Aruba Cloud, if any.",
+                    "type": "object"
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.CategoryResponseDto": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name is the name of the category.",
+                    "type": "string"
+                },
+                "provider": {
+                    "description": "Provider is the provider of the category.",
+                    "type": "string"
+                },
+                "typology": {
+                    "description": "Typology is the typology of the category.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.TypologyResponseDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.DisableStatusInfoResponseDto": {
+            "type": "object",
+            "properties": {
+                "isDisabled": {
+                    "description": "IsDisabled indicates if the resource is disabled.",
+                    "type": "boolean"
+                },
+                "previousStatus": {
+                    "description": "PreviousStatus is the previous status of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.PreviousStatusResponseDto"
+                        }
+                    ]
+                },
+                "reasons": {
+                    "description": "Reasons is a list of reasons for the disabled status.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.FlattenedCreateSnapshotRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for the snapshot.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.SnapshotPropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.FlattenedCreateVolumeRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for the volume.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.VolumePropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.FlattenedSnapshotListResponseDto": {
+            "type": "object",
+            "properties": {
+                "first": {
+                    "description": "First is the URI of the first page.",
+                    "type": "string"
+                },
+                "last": {
+                    "description": "Last is the URI of the last page.",
+                    "type": "string"
+                },
+                "next": {
+                    "description": "Next is the URI of the next page.",
+                    "type": "string"
+                },
+                "prev": {
+                    "description": "Prev is the URI of the previous page.",
+                    "type": "string"
+                },
+                "self": {
+                    "description": "Self is the URI of the current page.",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total is the total number of snapshots.",
+                    "type": "integer"
+                },
+                "values": {
+                    "description": "Values is a list of flattened snapshots.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.FlattenedSnapshotResponseDto"
+                    }
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.FlattenedSnapshotResponseDto": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Category is the category of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.CategoryResponseDto"
+                        }
+                    ]
+                },
+                "createdBy": {
+                    "description": "CreatedBy is the user who created the resource.",
+                    "type": "string"
+                },
+                "createdUser": {
+                    "description": "CreatedUser is the user who created the resource.",
+                    "type": "string"
+                },
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the resource.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the resource.",
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Location is the region where the resource is located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.LocationResponseDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name is the name of the resource.",
+                    "type": "string"
+                },
+                "project": {
+                    "description": "Project is the project where the resource belongs.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.ProjectResponseDto"
+                        }
+                    ]
+                },
+                "properties": {
+                    "description": "Properties contains the properties of the snapshot.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.SnapshotPropertiesResponseDto"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Status contains the status of the snapshot.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.StatusResponseDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updateDate": {
+                    "description": "UpdateDate is the last update date of the resource.",
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "description": "UpdatedBy is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "updatedUser": {
+                    "description": "UpdatedUser is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "uri": {
+                    "description": "URI is the URI of the resource.",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the version of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.FlattenedUpdateSnapshotRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.FlattenedUpdateVolumeRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for updating the volume.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.VolumeUpdatePropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.FlattenedVolumeListResponseDto": {
+            "type": "object",
+            "properties": {
+                "first": {
+                    "description": "First is the URI of the first page.",
+                    "type": "string"
+                },
+                "last": {
+                    "description": "Last is the URI of the last page.",
+                    "type": "string"
+                },
+                "next": {
+                    "description": "Next is the URI of the next page.",
+                    "type": "string"
+                },
+                "prev": {
+                    "description": "Prev is the URI of the previous page.",
+                    "type": "string"
+                },
+                "self": {
+                    "description": "Self is the URI of the current page.",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total is the total number of volumes.",
+                    "type": "integer"
+                },
+                "values": {
+                    "description": "Values is a list of flattened volumes.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.FlattenedVolumeResponseDto"
+                    }
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.FlattenedVolumeResponseDto": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Category is the category of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.CategoryResponseDto"
+                        }
+                    ]
+                },
+                "createdBy": {
+                    "description": "CreatedBy is the user who created the resource.",
+                    "type": "string"
+                },
+                "createdUser": {
+                    "description": "CreatedUser is the user who created the resource.",
+                    "type": "string"
+                },
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the resource.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the resource.",
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Location is the region where the resource is located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.LocationResponseDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name is the name of the resource.",
+                    "type": "string"
+                },
+                "project": {
+                    "description": "Project is the project where the resource belongs.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.ProjectResponseDto"
+                        }
+                    ]
+                },
+                "properties": {
+                    "description": "Properties contains the properties of the volume.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.VolumePropertiesResponseDto"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Status contains the status of the volume.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.StatusResponseDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updateDate": {
+                    "description": "UpdateDate is the last update date of the resource.",
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "description": "UpdatedBy is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "updatedUser": {
+                    "description": "UpdatedUser is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "uri": {
+                    "description": "URI is the URI of the resource.",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the version of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.LinkedResourceResponseDto": {
+            "type": "object",
+            "properties": {
+                "strictCorrelation": {
+                    "description": "StrictCorrelation indicates if the correlation is strict.",
+                    "type": "boolean"
+                },
+                "uri": {
+                    "description": "URI is the URI of the linked resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.LocationDto": {
+            "type": "object",
+            "properties": {
+                "value": {
+                    "description": "Value is the region where the resource will be located.\nAvailable regions at present: ITBG-Bergamo.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.LocationResponseDto": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "description": "City is the city of the region.",
+                    "type": "string"
+                },
+                "code": {
+                    "description": "Code is the code of the region.",
+                    "type": "string"
+                },
+                "country": {
+                    "description": "Country is the country of the region.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of the region.",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value is the value of the region.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.PreviousStatusResponseDto": {
+            "type": "object",
+            "properties": {
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the previous status.",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "State is the previous state of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.ProjectResponseDto": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID is the unique identifier of the project.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.ReferenceDto": {
+            "type": "object",
+            "properties": {
+                "uri": {
+                    "description": "URI is the URI of the referenced resource,\ne.g. /projects/\u003cPROJECT_ID\u003e/providers/Aruba.Storage/blockStorages/\u003cVOLUME_ID\u003e.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.SnapshotPropertiesDto": {
+            "type": "object",
+            "properties": {
+                "volume": {
+                    "description": "Volume is the volume the snapshot is taken from.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.SnapshotPropertiesResponseDto": {
+            "type": "object",
+            "properties": {
+                "linkedResources": {
+                    "description": "LinkedResources is a list of the resources linked to the snapshot, e.g. the volumes restored from it.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.LinkedResourceResponseDto"
+                    }
+                },
+                "sizeGb": {
+                    "description": "SizeGb is the size of the snapshot in GB.",
+                    "type": "integer"
+                },
+                "volume": {
+                    "description": "Volume is the volume the snapshot has been taken from.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.StatusResponseDto": {
+            "type": "object",
+            "properties": {
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the status.",
+                    "type": "string"
+                },
+                "disableStatusInfo": {
+                    "description": "DisableStatusInfo contains the information about the disabled status of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.DisableStatusInfoResponseDto"
+                        }
+                    ]
+                },
+                "failureReason": {
+                    "description": "FailureReason is the reason of the failure, if any.",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "State is the state of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.TypologyResponseDto": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID is the unique identifier of the typology.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of the typology.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.VolumePropertiesDto": {
+            "type": "object",
+            "properties": {
+                "bootable": {
+                    "description": "Bootable indicates if the volume can be used as boot volume of a cloud server.",
+                    "type": "boolean"
+                },
+                "image": {
+                    "description": "Image is the ID of the image written on a bootable volume.",
+                    "type": "string"
+                },
+                "sizeGb": {
+                    "description": "SizeGb is the size of the volume in GB.\nWhen the volume is restored from a snapshot, it defaults to the size of the snapshot.",
+                    "type": "integer"
+                },
+                "snapshot": {
+                    "description": "Snapshot is the snapshot the volume is restored from.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "type": {
+                    "description": "Type is the performance class of the volume.\nAllowed values: Standard, Performance.",
+                    "type": "string"
+                },
+                "zone": {
+                    "description": "Zone is the availability zone of the volume, e.g. ITBG-1.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.VolumePropertiesResponseDto": {
+            "type": "object",
+            "properties": {
+                "attachedTo": {
+                    "description": "AttachedTo is the cloud server the volume is attached to, empty when the volume is detached.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "bootable": {
+                    "description": "Bootable indicates if the volume can be used as boot volume of a cloud server.",
+                    "type": "boolean"
+                },
+                "image": {
+                    "description": "Image is the ID of the image written on a bootable volume.",
+                    "type": "string"
+                },
+                "linkedResources": {
+                    "description": "LinkedResources is a list of the resources linked to the volume, e.g. the cloud server it is attached to.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.LinkedResourceResponseDto"
+                    }
+                },
+                "sizeGb": {
+                    "description": "SizeGb is the size of the volume in GB.",
+                    "type": "integer"
+                },
+                "snapshot": {
+                    "description": "Snapshot is the snapshot the volume has been restored from.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_blockstorage-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "type": {
+                    "description": "Type is the performance class of the volume.",
+                    "type": "string"
+                },
+                "zone": {
+                    "description": "Zone is the availability zone of the volume.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_blockstorage-plugin_handlers.VolumeUpdatePropertiesDto": {
+            "type": "object",
+            "properties": {
+                "sizeGb": {
+                    "description": "SizeGb is the size of the volume in GB. A volume can only be extended.",
+                    "type": "integer"
+                }
+            }
+        }
+    }
+}`
+
+// SwaggerInfo holds exported Swagger Info so clients can modify it
+var SwaggerInfo = &swag.Spec{
+	Version:          "1.0",
+	Host:             "localhost:8080",
+	BasePath:         "/",
+	Schemes:          []string{"http"},
+	Title:            "Aruba Cloud Block Storage Plugin API for Krateo Operator Generator (KOG)",
+	Description:      "Simple wrapper around Aruba Cloud API to provide consistency of API response for Krateo Operator Generator (KOG)",
+	InfoInstanceName: "swagger",
+	SwaggerTemplate:  docTemplate,
+	LeftDelim:        "{{",
+	RightDelim:       "}}",
+}
+
+func init() {
+	swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+}
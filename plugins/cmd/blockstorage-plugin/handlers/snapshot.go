@@ -0,0 +1,156 @@
+package blockstorage
+
+import (
+	"errors"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+)
+
+// Snapshot is the Aruba Cloud snapshot resource of a block storage volume
+var Snapshot = handlers.Resource{
+	Name:          "snapshot",
+	Plural:        "snapshots",
+	Path:          "/projects/{projectId}/providers/Aruba.Storage/snapshots",
+	PathParams:    []handlers.Param{handlers.ProjectIDParam},
+	IDParam:       handlers.Param{Name: "id", Label: "Snapshot ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetSnapshot
+// @Summary Get a snapshot from Aruba Cloud
+// @Description Get a snapshot from Aruba Cloud using the provided project and snapshot details.
+// @ID get-snapshot
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Snapshot ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedSnapshotResponseDto "Snapshot details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/snapshots/{id} [get]
+func GetSnapshot(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[SnapshotResponseDto](opts, Snapshot)
+}
+
+// PostSnapshot
+// @Summary Create a new snapshot on Aruba Cloud
+// @Description Create a new snapshot on Aruba Cloud using the provided project details.
+// @ID post-snapshot
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param snapshotCreate body FlattenedCreateSnapshotRequestDto true "Snapshot creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedSnapshotResponseDto "Snapshot details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/snapshots [post]
+func PostSnapshot(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateSnapshotRequestDto, SnapshotDto, SnapshotResponseDto](opts, Snapshot,
+		func(req FlattenedCreateSnapshotRequestDto) SnapshotDto {
+			return SnapshotDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutSnapshot
+// @Summary Update a snapshot on Aruba Cloud
+// @Description Update a snapshot on Aruba Cloud using the provided project and snapshot details.
+// @Description Only the name, location and tags of a snapshot can be updated.
+// @ID put-snapshot
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Snapshot ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param snapshotUpdate body FlattenedUpdateSnapshotRequestDto true "Snapshot update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedSnapshotResponseDto "Snapshot details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/snapshots/{id} [put]
+func PutSnapshot(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateSnapshotRequestDto, SnapshotUpdateDto, SnapshotResponseDto](opts, Snapshot,
+		func(req FlattenedUpdateSnapshotRequestDto) SnapshotUpdateDto {
+			return SnapshotUpdateDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+			}
+		})
+}
+
+// ListSnapshots
+// @Summary List snapshots on Aruba Cloud
+// @Description List snapshots on Aruba Cloud using the provided project details.
+// @ID list-snapshots
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedSnapshotListResponseDto "A list of snapshots"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/snapshots [get]
+func ListSnapshots(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[SnapshotResponseDto, FlattenedSnapshotResponseDto](opts, Snapshot)
+}
+
+// DeleteSnapshot
+// @Summary Delete a snapshot on Aruba Cloud
+// @Description Delete a snapshot on Aruba Cloud using the provided project and snapshot details.
+// @Description Deleting a snapshot that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-snapshot
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Snapshot ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/snapshots/{id} [delete]
+func DeleteSnapshot(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, Snapshot)
+}
+
+// Validate checks that the snapshot references its source volume before it is sent to Aruba Cloud
+func (req FlattenedCreateSnapshotRequestDto) Validate() error {
+	if req.Properties == nil || req.Properties.Volume == nil || req.Properties.Volume.URI == "" {
+		return errors.New("properties.volume.uri is required")
+	}
+	return nil
+}
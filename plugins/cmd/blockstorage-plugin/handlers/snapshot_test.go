@@ -0,0 +1,122 @@
+package blockstorage
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// TestSnapshotHandlers tests the calls made to Aruba Cloud for the snapshots of the volumes
+func TestSnapshotHandlers(t *testing.T) {
+	const snapshotsURI = "/projects/p1/providers/Aruba.Storage/snapshots"
+	snapshot := `{"metadata":{"id":"snap1","name":"nightly"},"status":{"state":"Active"},"properties":{"volume":{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/vol1"},"sizeGb":20}}`
+	flattened := `{"id":"snap1","name":"nightly","properties":{"sizeGb":20,"volume":{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/vol1"}},"status":{"state":"Active"}}`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		upstreamStatus int
+		upstreamBody   string
+		expectedCall   handlertest.Call
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "get",
+			method:         http.MethodGet,
+			target:         snapshotsURI + "/snap1?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   snapshot,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: snapshotsURI + "/snap1?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "create",
+			method:         http.MethodPost,
+			target:         snapshotsURI + "?api-version=1.0",
+			body:           `{"name":"nightly","properties":{"volume":{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/vol1"}}}`,
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   snapshot,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: snapshotsURI + "?api-version=1.0", Body: `{"metadata":{"name":"nightly"},"properties":{"volume":{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/vol1"}}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "update only carries the metadata",
+			method:         http.MethodPut,
+			target:         snapshotsURI + "/snap1?api-version=1.0",
+			body:           `{"name":"nightly","tags":["prod"],"properties":{"volume":{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/vol2"}}}`,
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   snapshot,
+			expectedCall:   handlertest.Call{Method: http.MethodPut, URI: snapshotsURI + "/snap1?api-version=1.0", Body: `{"metadata":{"name":"nightly","tags":["prod"]}}`},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.upstreamStatus)
+				w.Write([]byte(tc.upstreamBody))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 1 || (*calls)[0] != tc.expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", tc.expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tc.expectedBody {
+				t.Errorf("expected body '%s', got '%s'", tc.expectedBody, got)
+			}
+		})
+	}
+}
+
+// TestSnapshotHandlers_Validation tests that snapshots without source volume are rejected before calling Aruba Cloud
+func TestSnapshotHandlers_Validation(t *testing.T) {
+	const snapshotsURI = "/projects/p1/providers/Aruba.Storage/snapshots"
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedDetail string
+	}{
+		{name: "missing properties", method: http.MethodPost, target: snapshotsURI + "?api-version=1.0", body: `{"name":"nightly"}`, expectedDetail: "properties.volume.uri is required"},
+		{name: "missing volume uri", method: http.MethodPost, target: snapshotsURI + "?api-version=1.0", body: `{"name":"nightly","properties":{"volume":{}}}`, expectedDetail: "properties.volume.uri is required"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 0 {
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			}
+			if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+				t.Fatalf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+			}
+			var problem handlers.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,356 @@
+package blockstorage
+
+type VolumeDto struct {
+	Metadata   *MetadataDto         `json:"metadata,omitempty"`
+	Properties *VolumePropertiesDto `json:"properties,omitempty"`
+}
+
+type VolumeUpdateDto struct {
+	Metadata   *MetadataDto               `json:"metadata,omitempty"`
+	Properties *VolumeUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+type SnapshotDto struct {
+	Metadata   *MetadataDto           `json:"metadata,omitempty"`
+	Properties *SnapshotPropertiesDto `json:"properties,omitempty"`
+}
+
+// SnapshotUpdateDto only carries the metadata, the properties of a snapshot cannot be updated
+type SnapshotUpdateDto struct {
+	Metadata *MetadataDto `json:"metadata,omitempty"`
+}
+
+type MetadataDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+}
+
+type LocationDto struct {
+	// Value is the region where the resource will be located.
+	// Available regions at present: ITBG-Bergamo.
+	Value string `json:"value,omitempty"`
+}
+
+type VolumePropertiesDto struct {
+	// SizeGb is the size of the volume in GB.
+	// When the volume is restored from a snapshot, it defaults to the size of the snapshot.
+	SizeGb int32 `json:"sizeGb,omitempty"`
+	// Type is the performance class of the volume.
+	// Allowed values: Standard, Performance.
+	Type string `json:"type,omitempty"`
+	// Zone is the availability zone of the volume, e.g. ITBG-1.
+	Zone string `json:"zone,omitempty"`
+	// Bootable indicates if the volume can be used as boot volume of a cloud server.
+	Bootable bool `json:"bootable,omitempty"`
+	// Image is the ID of the image written on a bootable volume.
+	Image string `json:"image,omitempty"`
+	// Snapshot is the snapshot the volume is restored from.
+	Snapshot *ReferenceDto `json:"snapshot,omitempty"`
+}
+
+type VolumeUpdatePropertiesDto struct {
+	// SizeGb is the size of the volume in GB. A volume can only be extended.
+	SizeGb int32 `json:"sizeGb,omitempty"`
+}
+
+type SnapshotPropertiesDto struct {
+	// Volume is the volume the snapshot is taken from.
+	Volume *ReferenceDto `json:"volume,omitempty"`
+}
+
+type ReferenceDto struct {
+	// URI is the URI of the referenced resource,
+	// e.g. /projects/<PROJECT_ID>/providers/Aruba.Storage/blockStorages/<VOLUME_ID>.
+	URI string `json:"uri,omitempty"`
+}
+
+type VolumeResponseDto struct {
+	Metadata   *MetadataResponseDto         `json:"metadata,omitempty"`
+	Status     *StatusResponseDto           `json:"status,omitempty"`
+	Properties *VolumePropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type SnapshotResponseDto struct {
+	Metadata   *MetadataResponseDto           `json:"metadata,omitempty"`
+	Status     *StatusResponseDto             `json:"status,omitempty"`
+	Properties *SnapshotPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type MetadataResponseDto struct {
+	ID           string               `json:"id,omitempty"`
+	URI          string               `json:"uri,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	Location     *LocationResponseDto `json:"location,omitempty"`
+	Project      *ProjectResponseDto  `json:"project,omitempty"`
+	Tags         []string             `json:"tags,omitempty"`
+	Category     *CategoryResponseDto `json:"category,omitempty"`
+	CreationDate string               `json:"creationDate,omitempty"`
+	CreatedBy    string               `json:"createdBy,omitempty"`
+	UpdateDate   string               `json:"updateDate,omitempty"`
+	UpdatedBy    string               `json:"updatedBy,omitempty"`
+	Version      string               `json:"version,omitempty"`
+	CreatedUser  string               `json:"createdUser,omitempty"`
+	UpdatedUser  string               `json:"updatedUser,omitempty"`
+}
+
+type LocationResponseDto struct {
+	// Code is the code of the region.
+	Code string `json:"code,omitempty"`
+	// Country is the country of the region.
+	Country string `json:"country,omitempty"`
+	// City is the city of the region.
+	City string `json:"city,omitempty"`
+	// Name is the name of the region.
+	Name string `json:"name,omitempty"`
+	// Value is the value of the region.
+	Value string `json:"value,omitempty"`
+}
+
+type ProjectResponseDto struct {
+	// ID is the unique identifier of the project.
+	ID string `json:"id,omitempty"`
+}
+
+type CategoryResponseDto struct {
+	// Name is the name of the category.
+	Name string `json:"name,omitempty"`
+	// Provider is the provider of the category.
+	Provider string `json:"provider,omitempty"`
+	// Typology is the typology of the category.
+	Typology *TypologyResponseDto `json:"typology,omitempty"`
+}
+
+type TypologyResponseDto struct {
+	// ID is the unique identifier of the typology.
+	ID string `json:"id,omitempty"`
+	// Name is the name of the typology.
+	Name string `json:"name,omitempty"`
+}
+
+type StatusResponseDto struct {
+	// State is the state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the status.
+	CreationDate string `json:"creationDate,omitempty"`
+	// DisableStatusInfo contains the information about the disabled status of the resource.
+	DisableStatusInfo *DisableStatusInfoResponseDto `json:"disableStatusInfo,omitempty"`
+	// FailureReason is the reason of the failure, if any.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+type DisableStatusInfoResponseDto struct {
+	// IsDisabled indicates if the resource is disabled.
+	IsDisabled bool `json:"isDisabled,omitempty"`
+	// Reasons is a list of reasons for the disabled status.
+	Reasons []string `json:"reasons,omitempty"`
+	// PreviousStatus is the previous status of the resource.
+	PreviousStatus *PreviousStatusResponseDto `json:"previousStatus,omitempty"`
+}
+
+type PreviousStatusResponseDto struct {
+	// State is the previous state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the previous status.
+	CreationDate string `json:"creationDate,omitempty"`
+}
+
+type VolumePropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the volume, e.g. the cloud server it is attached to.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// SizeGb is the size of the volume in GB.
+	SizeGb int32 `json:"sizeGb,omitempty"`
+	// Type is the performance class of the volume.
+	Type string `json:"type,omitempty"`
+	// Zone is the availability zone of the volume.
+	Zone string `json:"zone,omitempty"`
+	// Bootable indicates if the volume can be used as boot volume of a cloud server.
+	Bootable bool `json:"bootable,omitempty"`
+	// Image is the ID of the image written on a bootable volume.
+	Image string `json:"image,omitempty"`
+	// Snapshot is the snapshot the volume has been restored from.
+	Snapshot *ReferenceDto `json:"snapshot,omitempty"`
+	// AttachedTo is the cloud server the volume is attached to, empty when the volume is detached.
+	AttachedTo *ReferenceDto `json:"attachedTo,omitempty"`
+}
+
+type SnapshotPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the snapshot, e.g. the volumes restored from it.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Volume is the volume the snapshot has been taken from.
+	Volume *ReferenceDto `json:"volume,omitempty"`
+	// SizeGb is the size of the snapshot in GB.
+	SizeGb int32 `json:"sizeGb,omitempty"`
+}
+
+type LinkedResourceResponseDto struct {
+	// URI is the URI of the linked resource.
+	URI string `json:"uri,omitempty"`
+	// StrictCorrelation indicates if the correlation is strict.
+	StrictCorrelation bool `json:"strictCorrelation,omitempty"`
+}
+
+// --------------------------------------------------------------------------
+// Flattened types
+// --------------------------------------------------------------------------
+
+// FlattenedCreateVolumeRequestDto is the flattened request body for creating a volume.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateVolumeRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the volume.
+	Properties *VolumePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateVolumeRequestDto is the flattened request body for updating a volume.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateVolumeRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the volume.
+	Properties *VolumeUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedVolumeResponseDto is the flattened response body for a single volume.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedVolumeResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the volume.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the volume.
+	Properties *VolumePropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedVolumeListResponseDto struct {
+	// Total is the total number of volumes.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened volumes.
+	Values []FlattenedVolumeResponseDto `json:"values,omitempty"`
+}
+
+// FlattenedCreateSnapshotRequestDto is the flattened request body for creating a snapshot.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateSnapshotRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the snapshot.
+	Properties *SnapshotPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateSnapshotRequestDto is the flattened request body for updating a snapshot.
+// The fields from MetadataDto are at the root level, the properties cannot be updated.
+type FlattenedUpdateSnapshotRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// FlattenedSnapshotResponseDto is the flattened response body for a single snapshot.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedSnapshotResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the snapshot.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the snapshot.
+	Properties *SnapshotPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedSnapshotListResponseDto struct {
+	// Total is the total number of snapshots.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened snapshots.
+	Values []FlattenedSnapshotResponseDto `json:"values,omitempty"`
+}
@@ -0,0 +1,184 @@
+package blockstorage
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// Volume is the Aruba Cloud block storage volume resource
+var Volume = handlers.Resource{
+	Name:          "volume",
+	Plural:        "volumes",
+	Path:          "/projects/{projectId}/providers/Aruba.Storage/blockStorages",
+	PathParams:    []handlers.Param{handlers.ProjectIDParam},
+	IDParam:       handlers.Param{Name: "id", Label: "Volume ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetVolume
+// @Summary Get a volume from Aruba Cloud
+// @Description Get a volume from Aruba Cloud using the provided project and volume details.
+// @Description The state of the volume is reported in status.state, e.g. InCreation while it is being provisioned and Active once it can be used.
+// @ID get-volume
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Volume ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVolumeResponseDto "Volume details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/blockStorages/{id} [get]
+func GetVolume(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[VolumeResponseDto](opts, Volume)
+}
+
+// PostVolume
+// @Summary Create a new volume on Aruba Cloud
+// @Description Create a new volume on Aruba Cloud using the provided project details.
+// @Description A volume is restored from a snapshot by referencing it in properties.snapshot.
+// @ID post-volume
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param volumeCreate body FlattenedCreateVolumeRequestDto true "Volume creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedVolumeResponseDto "Volume details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/blockStorages [post]
+func PostVolume(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateVolumeRequestDto, VolumeDto, VolumeResponseDto](opts, Volume,
+		func(req FlattenedCreateVolumeRequestDto) VolumeDto {
+			return VolumeDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutVolume
+// @Summary Update a volume on Aruba Cloud
+// @Description Update a volume on Aruba Cloud using the provided project and volume details.
+// @ID put-volume
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Volume ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param volumeUpdate body FlattenedUpdateVolumeRequestDto true "Volume update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVolumeResponseDto "Volume details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/blockStorages/{id} [put]
+func PutVolume(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateVolumeRequestDto, VolumeUpdateDto, VolumeResponseDto](opts, Volume,
+		func(req FlattenedUpdateVolumeRequestDto) VolumeUpdateDto {
+			return VolumeUpdateDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListVolumes
+// @Summary List volumes on Aruba Cloud
+// @Description List volumes on Aruba Cloud using the provided project details.
+// @ID list-volumes
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVolumeListResponseDto "A list of volumes"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/blockStorages [get]
+func ListVolumes(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[VolumeResponseDto, FlattenedVolumeResponseDto](opts, Volume)
+}
+
+// DeleteVolume
+// @Summary Delete a volume on Aruba Cloud
+// @Description Delete a volume on Aruba Cloud using the provided project and volume details.
+// @Description Deleting a volume that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-volume
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Volume ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Storage/blockStorages/{id} [delete]
+func DeleteVolume(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, Volume)
+}
+
+// Volume types accepted by Aruba Cloud
+const (
+	VolumeTypeStandard    = "Standard"
+	VolumeTypePerformance = "Performance"
+)
+
+// Validate checks the size and type of the volume before it is sent to Aruba Cloud
+func (req FlattenedCreateVolumeRequestDto) Validate() error {
+	props := req.Properties
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if props.Snapshot == nil && props.SizeGb <= 0 {
+		return errors.New("properties.sizeGb must be greater than 0 unless the volume is restored from properties.snapshot")
+	}
+	if props.SizeGb < 0 {
+		return fmt.Errorf("properties.sizeGb must not be negative, got %d", props.SizeGb)
+	}
+	if props.Type != "" {
+		return validation.OneOf("properties.type", props.Type, VolumeTypeStandard, VolumeTypePerformance)
+	}
+	return nil
+}
+
+// Validate checks the size of the volume before it is sent to Aruba Cloud
+func (req FlattenedUpdateVolumeRequestDto) Validate() error {
+	if req.Properties != nil && req.Properties.SizeGb < 0 {
+		return fmt.Errorf("properties.sizeGb must not be negative, got %d", req.Properties.SizeGb)
+	}
+	return nil
+}
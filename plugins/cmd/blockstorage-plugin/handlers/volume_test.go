@@ -0,0 +1,162 @@
+package blockstorage
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// newTestMux serves the volume and snapshot handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	mux := http.NewServeMux()
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Storage/blockStorages", ListVolumes(opts))
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Storage/blockStorages/{id}", GetVolume(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Storage/blockStorages", PostVolume(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Storage/blockStorages/{id}", PutVolume(opts))
+	mux.Handle("DELETE /projects/{projectId}/providers/Aruba.Storage/blockStorages/{id}", DeleteVolume(opts))
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Storage/snapshots", ListSnapshots(opts))
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Storage/snapshots/{id}", GetSnapshot(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Storage/snapshots", PostSnapshot(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Storage/snapshots/{id}", PutSnapshot(opts))
+	mux.Handle("DELETE /projects/{projectId}/providers/Aruba.Storage/snapshots/{id}", DeleteSnapshot(opts))
+	return mux, calls
+}
+
+// TestVolumeHandlers tests the calls made to Aruba Cloud for the volumes and the exposure of their state and attachment
+func TestVolumeHandlers(t *testing.T) {
+	const volumesURI = "/projects/p1/providers/Aruba.Storage/blockStorages"
+	volume := `{"metadata":{"id":"vol1","name":"data"},"status":{"state":"InCreation"},"properties":{"sizeGb":20,"type":"Performance","zone":"ITBG-1","attachedTo":{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/cs1"}}}`
+	flattened := `{"id":"vol1","name":"data","properties":{"attachedTo":{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/cs1"},"sizeGb":20,"type":"Performance","zone":"ITBG-1"},"status":{"state":"InCreation"}}`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		upstreamStatus int
+		upstreamBody   string
+		expectedCall   handlertest.Call
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "get",
+			method:         http.MethodGet,
+			target:         volumesURI + "/vol1?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   volume,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: volumesURI + "/vol1?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "create",
+			method:         http.MethodPost,
+			target:         volumesURI + "?api-version=1.0",
+			body:           `{"name":"data","properties":{"sizeGb":20,"type":"Performance","zone":"ITBG-1"}}`,
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   volume,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: volumesURI + "?api-version=1.0", Body: `{"metadata":{"name":"data"},"properties":{"sizeGb":20,"type":"Performance","zone":"ITBG-1"}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "restore from a snapshot",
+			method:         http.MethodPost,
+			target:         volumesURI + "?api-version=1.0",
+			body:           `{"name":"data","properties":{"snapshot":{"uri":"/projects/p1/providers/Aruba.Storage/snapshots/snap1"}}}`,
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   volume,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: volumesURI + "?api-version=1.0", Body: `{"metadata":{"name":"data"},"properties":{"snapshot":{"uri":"/projects/p1/providers/Aruba.Storage/snapshots/snap1"}}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "update only carries the size",
+			method:         http.MethodPut,
+			target:         volumesURI + "/vol1?api-version=1.0",
+			body:           `{"name":"data","properties":{"sizeGb":40,"type":"Standard"}}`,
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   volume,
+			expectedCall:   handlertest.Call{Method: http.MethodPut, URI: volumesURI + "/vol1?api-version=1.0", Body: `{"metadata":{"name":"data"},"properties":{"sizeGb":40}}`},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "delete",
+			method:         http.MethodDelete,
+			target:         volumesURI + "/vol1?api-version=1.0",
+			upstreamStatus: http.StatusAccepted,
+			expectedCall:   handlertest.Call{Method: http.MethodDelete, URI: volumesURI + "/vol1?api-version=1.0"},
+			expectedStatus: http.StatusAccepted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.upstreamStatus)
+				w.Write([]byte(tc.upstreamBody))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 1 || (*calls)[0] != tc.expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", tc.expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tc.expectedBody {
+				t.Errorf("expected body '%s', got '%s'", tc.expectedBody, got)
+			}
+		})
+	}
+}
+
+// TestVolumeHandlers_Validation tests that invalid volumes are rejected before calling Aruba Cloud
+func TestVolumeHandlers_Validation(t *testing.T) {
+	const volumesURI = "/projects/p1/providers/Aruba.Storage/blockStorages"
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedDetail string
+	}{
+		{name: "missing properties", method: http.MethodPost, target: volumesURI + "?api-version=1.0", body: `{"name":"data"}`, expectedDetail: "properties are required"},
+		{name: "missing size", method: http.MethodPost, target: volumesURI + "?api-version=1.0", body: `{"name":"data","properties":{"type":"Standard"}}`, expectedDetail: "properties.sizeGb must be greater than 0 unless the volume is restored from properties.snapshot"},
+		{name: "negative size of a restored volume", method: http.MethodPost, target: volumesURI + "?api-version=1.0", body: `{"name":"data","properties":{"sizeGb":-1,"snapshot":{"uri":"/projects/p1/providers/Aruba.Storage/snapshots/snap1"}}}`, expectedDetail: "properties.sizeGb must not be negative, got -1"},
+		{name: "unknown type", method: http.MethodPost, target: volumesURI + "?api-version=1.0", body: `{"name":"data","properties":{"sizeGb":20,"type":"Fast"}}`, expectedDetail: "properties.type must be one of Standard, Performance, got 'Fast'"},
+		{name: "negative size on update", method: http.MethodPut, target: volumesURI + "/vol1?api-version=1.0", body: `{"name":"data","properties":{"sizeGb":-1}}`, expectedDetail: "properties.sizeGb must not be negative, got -1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 0 {
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			}
+			if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+				t.Fatalf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+			}
+			var problem handlers.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
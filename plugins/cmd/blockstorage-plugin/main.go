@@ -0,0 +1,56 @@
+package main
+
+import (
+	blockstorage "github.com/krateoplatformops/arubacloud-provider-kog/blockstorage-plugin/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/health"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/server"
+	httpSwagger "github.com/swaggo/http-swagger"
+)
+
+// @title           Aruba Cloud Block Storage Plugin API for Krateo Operator Generator (KOG)
+// @version         1.0
+// @description     Simple wrapper around Aruba Cloud API to provide consistency of API response for Krateo Operator Generator (KOG)
+// @termsOfService  http://swagger.io/terms/
+// @contact.name    Krateo Support
+// @contact.url     https://krateo.io
+// @contact.email   contact@krateoplatformops.io
+// @license.name    Apache 2.0
+// @license.url     http://www.apache.org/licenses/LICENSE-2.0.html
+// @host            localhost:8080
+// @BasePath        /
+// @schemes         http
+func main() {
+	srv := server.New()
+
+	opts := handlers.HandlerOptions{
+		Log:      srv.Logger(),
+		Client:   srv.HTTPClient(),
+		BaseURL:  srv.BaseURL(),
+		Auth:     srv.TokenProvider(),
+		Timeouts: srv.UpstreamTimeouts(),
+	}
+
+	// Volume
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Storage/blockStorages", blockstorage.PostVolume(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Storage/blockStorages", blockstorage.ListVolumes(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Storage/blockStorages/{id}", blockstorage.GetVolume(opts))
+	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Storage/blockStorages/{id}", blockstorage.PutVolume(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Storage/blockStorages/{id}", blockstorage.DeleteVolume(opts))
+
+	// Snapshot
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Storage/snapshots", blockstorage.PostSnapshot(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Storage/snapshots", blockstorage.ListSnapshots(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Storage/snapshots/{id}", blockstorage.GetSnapshot(opts))
+	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Storage/snapshots/{id}", blockstorage.PutSnapshot(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Storage/snapshots/{id}", blockstorage.DeleteSnapshot(opts))
+
+	// Swagger UI
+	srv.Mux().Handle("/swagger/", httpSwagger.WrapHandler)
+
+	// Kubernetes health check endpoints
+	srv.Mux().HandleFunc("GET /healthz", health.LivenessHandler(srv.Healthy()))
+	srv.Mux().HandleFunc("GET /readyz", srv.ReadinessHandler())
+
+	srv.Run()
+}
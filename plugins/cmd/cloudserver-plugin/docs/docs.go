@@ -0,0 +1,1218 @@
+// Package docs Code generated by swaggo/swag. DO NOT EDIT
+package docs
+
+import "github.com/swaggo/swag"
+
+const docTemplate = `{
+    "schemes": {{ marshal .Schemes }},
+    "swagger": "2.0",
+    "info": {
+        "description": "{{escape .Description}}",
+        "title": "{{.Title}}",
+        "termsOfService": "http://swagger.io/terms/",
+        "contact": {
+            "name": "Krateo Support",
+            "url": "https://krateo.io",
+            "email": "contact@krateoplatformops.io"
+        },
+        "license": {
+            "name": "Apache 2.0",
+            "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+        },
+        "version": "{{.Version}}"
+    },
+    "host": "{{.Host}}",
+    "basePath": "{{.BasePath}}",
+    "paths": {
+        "/projects/{projectId}/providers/Aruba.Compute/cloudServers": {
+            "get": {
+                "description": "List cloud servers on Aruba Cloud using the provided project details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List cloud servers on Aruba Cloud",
+                "operationId": "list-cloud-servers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort expression",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Projection expression",
+                        "name": "projection",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A list of cloud servers",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.FlattenedCloudServerListResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new cloud server on Aruba Cloud using the provided project details.\nThe flavor, the boot disk (image or boot volume) and the subnets of the network interfaces are validated before calling Aruba Cloud.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create a new cloud server on Aruba Cloud",
+                "operationId": "post-cloud-server",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Cloud server creation request body",
+                        "name": "cloudServerCreate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.FlattenedCreateCloudServerRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Cloud server details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.FlattenedCloudServerResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Compute/cloudServers/{id}": {
+            "get": {
+                "description": "Get a cloud server from Aruba Cloud using the provided project and cloud server details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a cloud server from Aruba Cloud",
+                "operationId": "get-cloud-server",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cloud Server ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag",
+                        "name": "ignoreDeletedStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cloud server details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.FlattenedCloudServerResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a cloud server on Aruba Cloud using the provided project and cloud server details.\nOnly the flavor, the network interfaces and the Elastic IP of a cloud server can be updated.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Update a cloud server on Aruba Cloud",
+                "operationId": "put-cloud-server",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cloud Server ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Cloud server update request body",
+                        "name": "cloudServerUpdate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.FlattenedUpdateCloudServerRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cloud server details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.FlattenedCloudServerResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a cloud server on Aruba Cloud using the provided project and cloud server details.\nDeleting a cloud server that does not exist or is already in 'Deleted' state is considered successful.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete a cloud server on Aruba Cloud",
+                "operationId": "delete-cloud-server",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cloud Server ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Compute/cloudServers/{id}/poweroff": {
+            "post": {
+                "description": "Power off a cloud server on Aruba Cloud using the provided project and cloud server details.\nThe response mirrors the status code returned by Aruba Cloud, the progress of the action is reported in the status of the cloud server.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Power off a cloud server on Aruba Cloud",
+                "operationId": "poweroff-cloud-server",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cloud Server ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cloud server details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.FlattenedCloudServerResponseDto"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Compute/cloudServers/{id}/poweron": {
+            "post": {
+                "description": "Power on a cloud server on Aruba Cloud using the provided project and cloud server details.\nThe response mirrors the status code returned by Aruba Cloud, the progress of the action is reported in the status of the cloud server.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Power on a cloud server on Aruba Cloud",
+                "operationId": "poweron-cloud-server",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cloud Server ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Cloud server details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.FlattenedCloudServerResponseDto"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "ProblemDetails": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "description": "Detail is a human-readable explanation of the error.",
+                    "type": "string"
+                },
+                "instance": {
+                    "description": "Instance is the path of the request that caused the error.",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status is the HTTP status code of the response.",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "Title is a short summary of the error type.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type is a URI identifying the error type.",
+                    "type": "string"
+                },
+                "upstream": {
+                    "description": "Upstream is the original error body returned by Aruba Cloud, if any.",
+                    "type": "object"
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.CategoryResponseDto": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name is the name of the category.",
+                    "type": "string"
+                },
+                "provider": {
+                    "description": "Provider is the provider of the category.",
+                    "type": "string"
+                },
+                "typology": {
+                    "description": "Typology is the typology of the category.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.TypologyResponseDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.CloudServerPropertiesDto": {
+            "type": "object",
+            "properties": {
+                "bootVolume": {
+                    "description": "BootVolume is an existing block storage volume used as boot disk, alternative to Image.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "elasticIp": {
+                    "description": "ElasticIp is the Elastic IP associated with the cloud server.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "flavorName": {
+                    "description": "FlavorName is the name of the flavor (CPU and RAM) of the cloud server, e.g. CSO4A8.",
+                    "type": "string"
+                },
+                "image": {
+                    "description": "Image is the ID of the image the boot volume is created from, alternative to BootVolume.",
+                    "type": "string"
+                },
+                "keyPair": {
+                    "description": "KeyPair is the SSH key pair installed on the cloud server.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "networkInterfaces": {
+                    "description": "NetworkInterfaces are the network interfaces of the cloud server, each attached to a subnet.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.NetworkInterfaceDto"
+                    }
+                },
+                "vpc": {
+                    "description": "Vpc is the VPC in which the cloud server is created.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "zone": {
+                    "description": "Zone is the availability zone of the cloud server, e.g. ITBG-1.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.CloudServerPropertiesResponseDto": {
+            "type": "object",
+            "properties": {
+                "bootVolume": {
+                    "description": "BootVolume is the boot volume of the cloud server.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "elasticIp": {
+                    "description": "ElasticIp is the Elastic IP associated with the cloud server.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "flavorName": {
+                    "description": "FlavorName is the name of the flavor of the cloud server.",
+                    "type": "string"
+                },
+                "image": {
+                    "description": "Image is the ID of the image the boot volume has been created from.",
+                    "type": "string"
+                },
+                "keyPair": {
+                    "description": "KeyPair is the SSH key pair installed on the cloud server.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "linkedResources": {
+                    "description": "LinkedResources is a list of the resources linked to the cloud server, e.g. its volumes.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.LinkedResourceResponseDto"
+                    }
+                },
+                "networkInterfaces": {
+                    "description": "NetworkInterfaces are the network interfaces of the cloud server.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.NetworkInterfaceResponseDto"
+                    }
+                },
+                "vpc": {
+                    "description": "Vpc is the VPC of the cloud server.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "zone": {
+                    "description": "Zone is the availability zone of the cloud server.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.CloudServerUpdatePropertiesDto": {
+            "type": "object",
+            "properties": {
+                "elasticIp": {
+                    "description": "ElasticIp is the Elastic IP associated with the cloud server.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "flavorName": {
+                    "description": "FlavorName is the name of the flavor (CPU and RAM) of the cloud server.\nChanging it resizes the cloud server.",
+                    "type": "string"
+                },
+                "networkInterfaces": {
+                    "description": "NetworkInterfaces are the network interfaces of the cloud server, each attached to a subnet.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.NetworkInterfaceDto"
+                    }
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.DisableStatusInfoResponseDto": {
+            "type": "object",
+            "properties": {
+                "isDisabled": {
+                    "description": "IsDisabled indicates if the resource is disabled.",
+                    "type": "boolean"
+                },
+                "previousStatus": {
+                    "description": "PreviousStatus is the previous status of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.PreviousStatusResponseDto"
+                        }
+                    ]
+                },
+                "reasons": {
+                    "description": "Reasons is a list of reasons for the disabled status.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.FlattenedCloudServerListResponseDto": {
+            "type": "object",
+            "properties": {
+                "first": {
+                    "description": "First is the URI of the first page.",
+                    "type": "string"
+                },
+                "last": {
+                    "description": "Last is the URI of the last page.",
+                    "type": "string"
+                },
+                "next": {
+                    "description": "Next is the URI of the next page.",
+                    "type": "string"
+                },
+                "prev": {
+                    "description": "Prev is the URI of the previous page.",
+                    "type": "string"
+                },
+                "self": {
+                    "description": "Self is the URI of the current page.",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total is the total number of cloud servers.",
+                    "type": "integer"
+                },
+                "values": {
+                    "description": "Values is a list of flattened cloud servers.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.FlattenedCloudServerResponseDto"
+                    }
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.FlattenedCloudServerResponseDto": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Category is the category of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.CategoryResponseDto"
+                        }
+                    ]
+                },
+                "createdBy": {
+                    "description": "CreatedBy is the user who created the resource.",
+                    "type": "string"
+                },
+                "createdUser": {
+                    "description": "CreatedUser is the user who created the resource.",
+                    "type": "string"
+                },
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the resource.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the resource.",
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Location is the region where the resource is located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.LocationResponseDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name is the name of the resource.",
+                    "type": "string"
+                },
+                "project": {
+                    "description": "Project is the project where the resource belongs.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.ProjectResponseDto"
+                        }
+                    ]
+                },
+                "properties": {
+                    "description": "Properties contains the properties of the cloud server.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.CloudServerPropertiesResponseDto"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Status contains the status of the cloud server.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.StatusResponseDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updateDate": {
+                    "description": "UpdateDate is the last update date of the resource.",
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "description": "UpdatedBy is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "updatedUser": {
+                    "description": "UpdatedUser is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "uri": {
+                    "description": "URI is the URI of the resource.",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the version of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.FlattenedCreateCloudServerRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for the cloud server.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.CloudServerPropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.FlattenedUpdateCloudServerRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for updating the cloud server.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.CloudServerUpdatePropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.LinkedResourceResponseDto": {
+            "type": "object",
+            "properties": {
+                "strictCorrelation": {
+                    "description": "StrictCorrelation indicates if the correlation is strict.",
+                    "type": "boolean"
+                },
+                "uri": {
+                    "description": "URI is the URI of the linked resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.LocationDto": {
+            "type": "object",
+            "properties": {
+                "value": {
+                    "description": "Value is the region where the resource will be located.\nAvailable regions at present: ITBG-Bergamo.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.LocationResponseDto": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "description": "City is the city of the region.",
+                    "type": "string"
+                },
+                "code": {
+                    "description": "Code is the code of the region.",
+                    "type": "string"
+                },
+                "country": {
+                    "description": "Country is the country of the region.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of the region.",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value is the value of the region.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.NetworkInterfaceDto": {
+            "type": "object",
+            "properties": {
+                "securityGroups": {
+                    "description": "SecurityGroups are the security groups applied to the network interface.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.ReferenceDto"
+                    }
+                },
+                "subnet": {
+                    "description": "Subnet is the subnet the network interface is attached to.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.NetworkInterfaceResponseDto": {
+            "type": "object",
+            "properties": {
+                "ips": {
+                    "description": "Ips are the private IP addresses assigned to the network interface.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "macAddress": {
+                    "description": "MacAddress is the MAC address of the network interface.",
+                    "type": "string"
+                },
+                "securityGroups": {
+                    "description": "SecurityGroups are the security groups applied to the network interface.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.ReferenceDto"
+                    }
+                },
+                "subnet": {
+                    "description": "Subnet is the subnet the network interface is attached to.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.PreviousStatusResponseDto": {
+            "type": "object",
+            "properties": {
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the previous status.",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "State is the previous state of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.ProjectResponseDto": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID is the unique identifier of the project.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.ReferenceDto": {
+            "type": "object",
+            "properties": {
+                "uri": {
+                    "description": "URI is the URI of the referenced resource,\ne.g. /projects/\u003cPROJECT_ID\u003e/providers/Aruba.Network/vpcs/\u003cVPC_ID\u003e/subnets/\u003cSUBNET_ID\u003e.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.StatusResponseDto": {
+            "type": "object",
+            "properties": {
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the status.",
+                    "type": "string"
+                },
+                "disableStatusInfo": {
+                    "description": "DisableStatusInfo contains the information about the disabled status of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_cloudserver-plugin_handlers.DisableStatusInfoResponseDto"
+                        }
+                    ]
+                },
+                "failureReason": {
+                    "description": "FailureReason is the reason of the failure, if any.",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "State is the state of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_cloudserver-plugin_handlers.TypologyResponseDto": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID is the unique identifier of the typology.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of the typology.",
+                    "type": "string"
+                }
+            }
+        }
+    }
+}`
+
+// SwaggerInfo holds exported Swagger Info so clients can modify it
+var SwaggerInfo = &swag.Spec{
+	Version:          "1.0",
+	Host:             "localhost:8080",
+	BasePath:         "/",
+	Schemes:          []string{"http"},
+	Title:            "Aruba Cloud Cloud Server Plugin API for Krateo Operator Generator (KOG)",
+	Description:      "Simple wrapper around Aruba Cloud API to provide consistency of API response for Krateo Operator Generator (KOG)",
+	InfoInstanceName: "swagger",
+	SwaggerTemplate:  docTemplate,
+	LeftDelim:        "{{",
+	RightDelim:       "}}",
+}
+
+func init() {
+	swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+}
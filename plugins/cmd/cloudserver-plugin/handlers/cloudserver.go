@@ -0,0 +1,235 @@
+package cloudserver
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+)
+
+// CloudServer is the Aruba Cloud cloud server resource
+var CloudServer = handlers.Resource{
+	Name:          "cloud server",
+	Plural:        "cloud servers",
+	Path:          "/projects/{projectId}/providers/Aruba.Compute/cloudServers",
+	PathParams:    []handlers.Param{handlers.ProjectIDParam},
+	IDParam:       handlers.Param{Name: "id", Label: "Cloud Server ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetCloudServer
+// @Summary Get a cloud server from Aruba Cloud
+// @Description Get a cloud server from Aruba Cloud using the provided project and cloud server details.
+// @ID get-cloud-server
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Cloud Server ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedCloudServerResponseDto "Cloud server details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Compute/cloudServers/{id} [get]
+func GetCloudServer(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[CloudServerResponseDto](opts, CloudServer)
+}
+
+// PostCloudServer
+// @Summary Create a new cloud server on Aruba Cloud
+// @Description Create a new cloud server on Aruba Cloud using the provided project details.
+// @Description The flavor, the boot disk (image or boot volume) and the subnets of the network interfaces are validated before calling Aruba Cloud.
+// @ID post-cloud-server
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param cloudServerCreate body FlattenedCreateCloudServerRequestDto true "Cloud server creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedCloudServerResponseDto "Cloud server details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Compute/cloudServers [post]
+func PostCloudServer(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateCloudServerRequestDto, CloudServerDto, CloudServerResponseDto](opts, CloudServer,
+		func(req FlattenedCreateCloudServerRequestDto) CloudServerDto {
+			return CloudServerDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutCloudServer
+// @Summary Update a cloud server on Aruba Cloud
+// @Description Update a cloud server on Aruba Cloud using the provided project and cloud server details.
+// @Description Only the flavor, the network interfaces and the Elastic IP of a cloud server can be updated.
+// @ID put-cloud-server
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Cloud Server ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param cloudServerUpdate body FlattenedUpdateCloudServerRequestDto true "Cloud server update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedCloudServerResponseDto "Cloud server details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Compute/cloudServers/{id} [put]
+func PutCloudServer(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateCloudServerRequestDto, CloudServerUpdateDto, CloudServerResponseDto](opts, CloudServer,
+		func(req FlattenedUpdateCloudServerRequestDto) CloudServerUpdateDto {
+			return CloudServerUpdateDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListCloudServers
+// @Summary List cloud servers on Aruba Cloud
+// @Description List cloud servers on Aruba Cloud using the provided project details.
+// @ID list-cloud-servers
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedCloudServerListResponseDto "A list of cloud servers"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Compute/cloudServers [get]
+func ListCloudServers(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[CloudServerResponseDto, FlattenedCloudServerResponseDto](opts, CloudServer)
+}
+
+// DeleteCloudServer
+// @Summary Delete a cloud server on Aruba Cloud
+// @Description Delete a cloud server on Aruba Cloud using the provided project and cloud server details.
+// @Description Deleting a cloud server that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-cloud-server
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Cloud Server ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Compute/cloudServers/{id} [delete]
+func DeleteCloudServer(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, CloudServer)
+}
+
+// PowerOnCloudServer
+// @Summary Power on a cloud server on Aruba Cloud
+// @Description Power on a cloud server on Aruba Cloud using the provided project and cloud server details.
+// @Description The response mirrors the status code returned by Aruba Cloud, the progress of the action is reported in the status of the cloud server.
+// @ID poweron-cloud-server
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Cloud Server ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedCloudServerResponseDto "Cloud server details"
+// @Success 202 "Accepted"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 409 {object} handlers.ProblemDetails "Conflict"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Compute/cloudServers/{id}/poweron [post]
+func PowerOnCloudServer(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Action[CloudServerResponseDto](opts, CloudServer, "power on", "poweron")
+}
+
+// PowerOffCloudServer
+// @Summary Power off a cloud server on Aruba Cloud
+// @Description Power off a cloud server on Aruba Cloud using the provided project and cloud server details.
+// @Description The response mirrors the status code returned by Aruba Cloud, the progress of the action is reported in the status of the cloud server.
+// @ID poweroff-cloud-server
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Cloud Server ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedCloudServerResponseDto "Cloud server details"
+// @Success 202 "Accepted"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 409 {object} handlers.ProblemDetails "Conflict"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Compute/cloudServers/{id}/poweroff [post]
+func PowerOffCloudServer(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Action[CloudServerResponseDto](opts, CloudServer, "power off", "poweroff")
+}
+
+// Validate checks the boot disk and the network interfaces of the cloud server before it is sent to Aruba Cloud
+func (req FlattenedCreateCloudServerRequestDto) Validate() error {
+	props := req.Properties
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if props.FlavorName == "" {
+		return errors.New("properties.flavorName is required")
+	}
+	if (props.Image == "") == (props.BootVolume == nil) {
+		return errors.New("exactly one of properties.image and properties.bootVolume must be set")
+	}
+	return validateNetworkInterfaces(props.NetworkInterfaces, true)
+}
+
+// Validate checks the network interfaces of the cloud server before they are sent to Aruba Cloud
+func (req FlattenedUpdateCloudServerRequestDto) Validate() error {
+	if req.Properties == nil {
+		return nil
+	}
+	return validateNetworkInterfaces(req.Properties.NetworkInterfaces, false)
+}
+
+// validateNetworkInterfaces checks that every network interface is attached to a subnet
+func validateNetworkInterfaces(nics []NetworkInterfaceDto, required bool) error {
+	if required && len(nics) == 0 {
+		return errors.New("at least one network interface is required in properties.networkInterfaces")
+	}
+	for i, nic := range nics {
+		if nic.Subnet == nil || nic.Subnet.URI == "" {
+			return fmt.Errorf("properties.networkInterfaces[%d].subnet is required", i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package cloudserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// newTestMux serves the cloud server handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	mux := http.NewServeMux()
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Compute/cloudServers", PostCloudServer(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Compute/cloudServers/{id}", PutCloudServer(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Compute/cloudServers/{id}/poweron", PowerOnCloudServer(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Compute/cloudServers/{id}/poweroff", PowerOffCloudServer(opts))
+	return mux, calls
+}
+
+// TestCloudServerHandlers tests the mapping of the network interfaces and the Elastic IP of the cloud servers sent to Aruba Cloud
+func TestCloudServerHandlers(t *testing.T) {
+	const serversURI = "/projects/p1/providers/Aruba.Compute/cloudServers"
+	const nics = `"networkInterfaces":[{"subnet":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/subnets/s1"},"securityGroups":[{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/securityGroups/sg1"}]},` +
+		`{"subnet":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/subnets/s2"}}]`
+	const elasticIp = `"elasticIp":{"uri":"/projects/p1/providers/Aruba.Network/elasticIps/eip1"}`
+	const vpc = `"vpc":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1"}`
+	const keyPair = `"keyPair":{"uri":"/projects/p1/providers/Aruba.Compute/keyPairs/kp1"}`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		upstreamStatus int
+		expectedCall   handlertest.Call
+	}{
+		{
+			name:           "create",
+			method:         http.MethodPost,
+			target:         serversURI + "?api-version=1.0",
+			body:           `{"name":"web","location":{"value":"ITBG-Bergamo"},"properties":{"zone":"ITBG-1",` + vpc + `,"flavorName":"CSO4A8","image":"img1",` + keyPair + `,` + nics + `,` + elasticIp + `}}`,
+			upstreamStatus: http.StatusCreated,
+			expectedCall: handlertest.Call{Method: http.MethodPost, URI: serversURI + "?api-version=1.0",
+				Body: `{"metadata":{"name":"web","location":{"value":"ITBG-Bergamo"}},"properties":{"zone":"ITBG-1",` + vpc + `,"flavorName":"CSO4A8","image":"img1",` + keyPair + `,` + nics + `,` + elasticIp + `}}`},
+		},
+		{
+			name:           "create from a boot volume",
+			method:         http.MethodPost,
+			target:         serversURI + "?api-version=1.0",
+			body:           `{"name":"web","properties":{"flavorName":"CSO4A8","bootVolume":{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/v1"},` + nics + `}}`,
+			upstreamStatus: http.StatusCreated,
+			expectedCall: handlertest.Call{Method: http.MethodPost, URI: serversURI + "?api-version=1.0",
+				Body: `{"metadata":{"name":"web"},"properties":{"flavorName":"CSO4A8","bootVolume":{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/v1"},` + nics + `}}`},
+		},
+		{
+			name:           "update leaves out the fields fixed at creation",
+			method:         http.MethodPut,
+			target:         serversURI + "/cs1?api-version=1.0",
+			body:           `{"name":"web","properties":{"zone":"ITBG-1",` + vpc + `,"flavorName":"CSO8A16","image":"img1",` + keyPair + `,` + nics + `,` + elasticIp + `}}`,
+			upstreamStatus: http.StatusOK,
+			expectedCall: handlertest.Call{Method: http.MethodPut, URI: serversURI + "/cs1?api-version=1.0",
+				Body: `{"metadata":{"name":"web"},"properties":{"flavorName":"CSO8A16",` + nics + `,` + elasticIp + `}}`},
+		},
+		{
+			name:           "power on",
+			method:         http.MethodPost,
+			target:         serversURI + "/cs1/poweron?api-version=1.0",
+			upstreamStatus: http.StatusAccepted,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: serversURI + "/cs1/poweron?api-version=1.0"},
+		},
+		{
+			name:           "power off",
+			method:         http.MethodPost,
+			target:         serversURI + "/cs1/poweroff?api-version=1.0",
+			upstreamStatus: http.StatusAccepted,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: serversURI + "/cs1/poweroff?api-version=1.0"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.upstreamStatus)
+				if tc.upstreamStatus != http.StatusAccepted {
+					w.Write([]byte(`{"metadata":{"id":"cs1","name":"web"},"status":{"state":"Active"}}`))
+				}
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 1 || (*calls)[0] != tc.expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", tc.expectedCall, *calls)
+			}
+			if rec.Code != tc.upstreamStatus {
+				t.Errorf("expected status %d, got %d", tc.upstreamStatus, rec.Code)
+			}
+		})
+	}
+}
+
+// TestCloudServerHandlers_PowerFailure tests that the failures of the power actions are returned as problems
+func TestCloudServerHandlers_PowerFailure(t *testing.T) {
+	mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"title":"Conflict","status":409,"detail":"the cloud server is already powered off"}`))
+	})
+
+	rec := handlertest.Serve(mux, http.MethodPost, "/projects/p1/providers/Aruba.Compute/cloudServers/cs1/poweroff?api-version=1.0", "")
+
+	if len(*calls) != 1 {
+		t.Errorf("expected one call to Aruba Cloud, got %+v", *calls)
+	}
+	if rec.Code != http.StatusConflict || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+		t.Fatalf("expected a 409 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	if !strings.Contains(rec.Body.String(), "already powered off") {
+		t.Errorf("expected the detail of Aruba Cloud, got '%s'", rec.Body.String())
+	}
+}
+
+// TestCloudServerHandlers_Validation tests that the cloud servers without boot disk or with detached network interfaces are rejected before calling Aruba Cloud
+func TestCloudServerHandlers_Validation(t *testing.T) {
+	const serversURI = "/projects/p1/providers/Aruba.Compute/cloudServers?api-version=1.0"
+	const serverURI = "/projects/p1/providers/Aruba.Compute/cloudServers/cs1?api-version=1.0"
+	const nics = `"networkInterfaces":[{"subnet":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/subnets/s1"}}]`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedDetail string
+	}{
+		{name: "no flavor", method: http.MethodPost, target: serversURI, body: `{"properties":{"image":"img1",` + nics + `}}`, expectedDetail: "properties.flavorName is required"},
+		{name: "no boot disk", method: http.MethodPost, target: serversURI, body: `{"properties":{"flavorName":"CSO4A8",` + nics + `}}`, expectedDetail: "exactly one of properties.image and properties.bootVolume must be set"},
+		{name: "image and boot volume", method: http.MethodPost, target: serversURI, body: `{"properties":{"flavorName":"CSO4A8","image":"img1","bootVolume":{"uri":"/projects/p1/providers/Aruba.Storage/blockStorages/v1"},` + nics + `}}`, expectedDetail: "exactly one of properties.image and properties.bootVolume must be set"},
+		{name: "no network interface", method: http.MethodPost, target: serversURI, body: `{"properties":{"flavorName":"CSO4A8","image":"img1"}}`, expectedDetail: "at least one network interface is required in properties.networkInterfaces"},
+		{name: "network interface without subnet on update", method: http.MethodPut, target: serverURI, body: `{"properties":{"networkInterfaces":[{"securityGroups":[{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/securityGroups/sg1"}]}]}}`, expectedDetail: "properties.networkInterfaces[0].subnet is required"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 0 {
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			}
+			if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+				t.Fatalf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+			}
+			var problem handlers.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
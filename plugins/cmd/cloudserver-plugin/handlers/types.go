@@ -0,0 +1,275 @@
+package cloudserver
+
+type CloudServerDto struct {
+	Metadata   *MetadataDto              `json:"metadata,omitempty"`
+	Properties *CloudServerPropertiesDto `json:"properties,omitempty"`
+}
+
+type CloudServerUpdateDto struct {
+	Metadata   *MetadataDto                    `json:"metadata,omitempty"`
+	Properties *CloudServerUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+type MetadataDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+}
+
+type LocationDto struct {
+	// Value is the region where the resource will be located.
+	// Available regions at present: ITBG-Bergamo.
+	Value string `json:"value,omitempty"`
+}
+
+type CloudServerPropertiesDto struct {
+	// Zone is the availability zone of the cloud server, e.g. ITBG-1.
+	Zone string `json:"zone,omitempty"`
+	// Vpc is the VPC in which the cloud server is created.
+	Vpc *ReferenceDto `json:"vpc,omitempty"`
+	// FlavorName is the name of the flavor (CPU and RAM) of the cloud server, e.g. CSO4A8.
+	FlavorName string `json:"flavorName,omitempty"`
+	// Image is the ID of the image the boot volume is created from, alternative to BootVolume.
+	Image string `json:"image,omitempty"`
+	// BootVolume is an existing block storage volume used as boot disk, alternative to Image.
+	BootVolume *ReferenceDto `json:"bootVolume,omitempty"`
+	// KeyPair is the SSH key pair installed on the cloud server.
+	KeyPair *ReferenceDto `json:"keyPair,omitempty"`
+	// NetworkInterfaces are the network interfaces of the cloud server, each attached to a subnet.
+	NetworkInterfaces []NetworkInterfaceDto `json:"networkInterfaces,omitempty"`
+	// ElasticIp is the Elastic IP associated with the cloud server.
+	ElasticIp *ReferenceDto `json:"elasticIp,omitempty"`
+}
+
+type CloudServerUpdatePropertiesDto struct {
+	// FlavorName is the name of the flavor (CPU and RAM) of the cloud server.
+	// Changing it resizes the cloud server.
+	FlavorName string `json:"flavorName,omitempty"`
+	// NetworkInterfaces are the network interfaces of the cloud server, each attached to a subnet.
+	NetworkInterfaces []NetworkInterfaceDto `json:"networkInterfaces,omitempty"`
+	// ElasticIp is the Elastic IP associated with the cloud server.
+	ElasticIp *ReferenceDto `json:"elasticIp,omitempty"`
+}
+
+type NetworkInterfaceDto struct {
+	// Subnet is the subnet the network interface is attached to.
+	Subnet *ReferenceDto `json:"subnet,omitempty"`
+	// SecurityGroups are the security groups applied to the network interface.
+	SecurityGroups []ReferenceDto `json:"securityGroups,omitempty"`
+}
+
+type ReferenceDto struct {
+	// URI is the URI of the referenced resource,
+	// e.g. /projects/<PROJECT_ID>/providers/Aruba.Network/vpcs/<VPC_ID>/subnets/<SUBNET_ID>.
+	URI string `json:"uri,omitempty"`
+}
+
+type CloudServerResponseDto struct {
+	Metadata   *MetadataResponseDto              `json:"metadata,omitempty"`
+	Status     *StatusResponseDto                `json:"status,omitempty"`
+	Properties *CloudServerPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type MetadataResponseDto struct {
+	ID           string               `json:"id,omitempty"`
+	URI          string               `json:"uri,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	Location     *LocationResponseDto `json:"location,omitempty"`
+	Project      *ProjectResponseDto  `json:"project,omitempty"`
+	Tags         []string             `json:"tags,omitempty"`
+	Category     *CategoryResponseDto `json:"category,omitempty"`
+	CreationDate string               `json:"creationDate,omitempty"`
+	CreatedBy    string               `json:"createdBy,omitempty"`
+	UpdateDate   string               `json:"updateDate,omitempty"`
+	UpdatedBy    string               `json:"updatedBy,omitempty"`
+	Version      string               `json:"version,omitempty"`
+	CreatedUser  string               `json:"createdUser,omitempty"`
+	UpdatedUser  string               `json:"updatedUser,omitempty"`
+}
+
+type LocationResponseDto struct {
+	// Code is the code of the region.
+	Code string `json:"code,omitempty"`
+	// Country is the country of the region.
+	Country string `json:"country,omitempty"`
+	// City is the city of the region.
+	City string `json:"city,omitempty"`
+	// Name is the name of the region.
+	Name string `json:"name,omitempty"`
+	// Value is the value of the region.
+	Value string `json:"value,omitempty"`
+}
+
+type ProjectResponseDto struct {
+	// ID is the unique identifier of the project.
+	ID string `json:"id,omitempty"`
+}
+
+type CategoryResponseDto struct {
+	// Name is the name of the category.
+	Name string `json:"name,omitempty"`
+	// Provider is the provider of the category.
+	Provider string `json:"provider,omitempty"`
+	// Typology is the typology of the category.
+	Typology *TypologyResponseDto `json:"typology,omitempty"`
+}
+
+type TypologyResponseDto struct {
+	// ID is the unique identifier of the typology.
+	ID string `json:"id,omitempty"`
+	// Name is the name of the typology.
+	Name string `json:"name,omitempty"`
+}
+
+type StatusResponseDto struct {
+	// State is the state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the status.
+	CreationDate string `json:"creationDate,omitempty"`
+	// DisableStatusInfo contains the information about the disabled status of the resource.
+	DisableStatusInfo *DisableStatusInfoResponseDto `json:"disableStatusInfo,omitempty"`
+	// FailureReason is the reason of the failure, if any.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+type DisableStatusInfoResponseDto struct {
+	// IsDisabled indicates if the resource is disabled.
+	IsDisabled bool `json:"isDisabled,omitempty"`
+	// Reasons is a list of reasons for the disabled status.
+	Reasons []string `json:"reasons,omitempty"`
+	// PreviousStatus is the previous status of the resource.
+	PreviousStatus *PreviousStatusResponseDto `json:"previousStatus,omitempty"`
+}
+
+type PreviousStatusResponseDto struct {
+	// State is the previous state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the previous status.
+	CreationDate string `json:"creationDate,omitempty"`
+}
+
+type CloudServerPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the cloud server, e.g. its volumes.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Zone is the availability zone of the cloud server.
+	Zone string `json:"zone,omitempty"`
+	// Vpc is the VPC of the cloud server.
+	Vpc *ReferenceDto `json:"vpc,omitempty"`
+	// FlavorName is the name of the flavor of the cloud server.
+	FlavorName string `json:"flavorName,omitempty"`
+	// Image is the ID of the image the boot volume has been created from.
+	Image string `json:"image,omitempty"`
+	// BootVolume is the boot volume of the cloud server.
+	BootVolume *ReferenceDto `json:"bootVolume,omitempty"`
+	// KeyPair is the SSH key pair installed on the cloud server.
+	KeyPair *ReferenceDto `json:"keyPair,omitempty"`
+	// NetworkInterfaces are the network interfaces of the cloud server.
+	NetworkInterfaces []NetworkInterfaceResponseDto `json:"networkInterfaces,omitempty"`
+	// ElasticIp is the Elastic IP associated with the cloud server.
+	ElasticIp *ReferenceDto `json:"elasticIp,omitempty"`
+}
+
+type NetworkInterfaceResponseDto struct {
+	// Subnet is the subnet the network interface is attached to.
+	Subnet *ReferenceDto `json:"subnet,omitempty"`
+	// SecurityGroups are the security groups applied to the network interface.
+	SecurityGroups []ReferenceDto `json:"securityGroups,omitempty"`
+	// MacAddress is the MAC address of the network interface.
+	MacAddress string `json:"macAddress,omitempty"`
+	// Ips are the private IP addresses assigned to the network interface.
+	Ips []string `json:"ips,omitempty"`
+}
+
+type LinkedResourceResponseDto struct {
+	// URI is the URI of the linked resource.
+	URI string `json:"uri,omitempty"`
+	// StrictCorrelation indicates if the correlation is strict.
+	StrictCorrelation bool `json:"strictCorrelation,omitempty"`
+}
+
+// --------------------------------------------------------------------------
+// Flattened types
+// --------------------------------------------------------------------------
+
+// FlattenedCreateCloudServerRequestDto is the flattened request body for creating a cloud server.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateCloudServerRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the cloud server.
+	Properties *CloudServerPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateCloudServerRequestDto is the flattened request body for updating a cloud server.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateCloudServerRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the cloud server.
+	Properties *CloudServerUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedCloudServerResponseDto is the flattened response body for a single cloud server.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedCloudServerResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the cloud server.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the cloud server.
+	Properties *CloudServerPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedCloudServerListResponseDto struct {
+	// Total is the total number of cloud servers.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened cloud servers.
+	Values []FlattenedCloudServerResponseDto `json:"values,omitempty"`
+}
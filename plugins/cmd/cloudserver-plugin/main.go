@@ -0,0 +1,51 @@
+package main
+
+import (
+	cloudserver "github.com/krateoplatformops/arubacloud-provider-kog/cloudserver-plugin/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/health"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/server"
+	httpSwagger "github.com/swaggo/http-swagger"
+)
+
+// @title           Aruba Cloud Cloud Server Plugin API for Krateo Operator Generator (KOG)
+// @version         1.0
+// @description     Simple wrapper around Aruba Cloud API to provide consistency of API response for Krateo Operator Generator (KOG)
+// @termsOfService  http://swagger.io/terms/
+// @contact.name    Krateo Support
+// @contact.url     https://krateo.io
+// @contact.email   contact@krateoplatformops.io
+// @license.name    Apache 2.0
+// @license.url     http://www.apache.org/licenses/LICENSE-2.0.html
+// @host            localhost:8080
+// @BasePath        /
+// @schemes         http
+func main() {
+	srv := server.New()
+
+	opts := handlers.HandlerOptions{
+		Log:      srv.Logger(),
+		Client:   srv.HTTPClient(),
+		BaseURL:  srv.BaseURL(),
+		Auth:     srv.TokenProvider(),
+		Timeouts: srv.UpstreamTimeouts(),
+	}
+
+	// Cloud Server
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Compute/cloudServers", cloudserver.PostCloudServer(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Compute/cloudServers", cloudserver.ListCloudServers(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Compute/cloudServers/{id}", cloudserver.GetCloudServer(opts))
+	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Compute/cloudServers/{id}", cloudserver.PutCloudServer(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Compute/cloudServers/{id}", cloudserver.DeleteCloudServer(opts))
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Compute/cloudServers/{id}/poweron", cloudserver.PowerOnCloudServer(opts))
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Compute/cloudServers/{id}/poweroff", cloudserver.PowerOffCloudServer(opts))
+
+	// Swagger UI
+	srv.Mux().Handle("/swagger/", httpSwagger.WrapHandler)
+
+	// Kubernetes health check endpoints
+	srv.Mux().HandleFunc("GET /healthz", health.LivenessHandler(srv.Healthy()))
+	srv.Mux().HandleFunc("GET /readyz", srv.ReadinessHandler())
+
+	srv.Run()
+}
@@ -0,0 +1,1074 @@
+// Package docs Code generated by swaggo/swag. DO NOT EDIT
+package docs
+
+import "github.com/swaggo/swag"
+
+const docTemplate = `{
+    "schemes": {{ marshal .Schemes }},
+    "swagger": "2.0",
+    "info": {
+        "description": "{{escape .Description}}",
+        "title": "{{.Title}}",
+        "termsOfService": "http://swagger.io/terms/",
+        "contact": {
+            "name": "Krateo Support",
+            "url": "https://krateo.io",
+            "email": "contact@krateoplatformops.io"
+        },
+        "license": {
+            "name": "Apache 2.0",
+            "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+        },
+        "version": "{{.Version}}"
+    },
+    "host": "{{.Host}}",
+    "basePath": "{{.BasePath}}",
+    "paths": {
+        "/projects/{projectId}/providers/Aruba.Container/registries": {
+            "get": {
+                "description": "List container registries on Aruba Cloud using the provided project details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List container registries on Aruba Cloud",
+                "operationId": "list-container-registries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort expression",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Projection expression",
+                        "name": "projection",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A list of container registries",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.FlattenedContainerRegistryListResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new container registry on Aruba Cloud using the provided project details.\nThe password of the admin user is generated by Aruba Cloud and served by the credentials endpoint.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create a new container registry on Aruba Cloud",
+                "operationId": "post-container-registry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Container registry creation request body",
+                        "name": "containerRegistryCreate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.FlattenedCreateContainerRegistryRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Container registry details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.FlattenedContainerRegistryResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Container/registries/{id}": {
+            "get": {
+                "description": "Get a container registry from Aruba Cloud using the provided project and container registry details.\nThe state of the registry is reported in status.state, e.g. InCreation while it is being provisioned and Active once it can be used.\nThe credentials of the admin user are never part of the response, they are served by the credentials endpoint.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a container registry from Aruba Cloud",
+                "operationId": "get-container-registry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Container Registry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag",
+                        "name": "ignoreDeletedStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Container registry details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.FlattenedContainerRegistryResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a container registry on Aruba Cloud using the provided project and container registry details.\nOnly the size and the security group of a container registry can be changed, its network and admin user are fixed at creation.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Update a container registry on Aruba Cloud",
+                "operationId": "put-container-registry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Container Registry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Container registry update request body",
+                        "name": "containerRegistryUpdate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.FlattenedUpdateContainerRegistryRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Container registry details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.FlattenedContainerRegistryResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a container registry on Aruba Cloud using the provided project and container registry details.\nDeleting a container registry that does not exist or is already in 'Deleted' state is considered successful.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete a container registry on Aruba Cloud",
+                "operationId": "delete-container-registry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Container Registry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Container/registries/{id}/credentials": {
+            "get": {
+                "description": "Get the credentials of the admin user of a container registry from Aruba Cloud using the provided project and container registry details.\nThe credentials are only served by this endpoint: they are redacted from the other responses and never logged.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get the credentials of a container registry from Aruba Cloud",
+                "operationId": "get-container-registry-credentials",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Container Registry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Credentials of the container registry",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.RegistryCredentialsResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "ProblemDetails": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "description": "Detail is a human-readable explanation of the error.",
+                    "type": "string"
+                },
+                "instance": {
+                    "description": "Instance is the path of the request that caused the error.",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status is the HTTP status code of the response.",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "Title is a short summary of the error type.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type is a URI identifying the error type.",
+                    "type": "string"
+                },
+                "upstream": {
+                    "description": "Upstream is the original error body returned by Aruba Cloud, if any.",
+                    "type": "object"
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.AdminUserDto": {
+            "type": "object",
+            "properties": {
+                "username": {
+                    "description": "Username is the name of the administrator of the registry.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.CategoryResponseDto": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name is the name of the category.",
+                    "type": "string"
+                },
+                "provider": {
+                    "description": "Provider is the provider of the category.",
+                    "type": "string"
+                },
+                "typology": {
+                    "description": "Typology is the typology of the category.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.TypologyResponseDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.ContainerRegistryPropertiesDto": {
+            "type": "object",
+            "properties": {
+                "adminUser": {
+                    "description": "AdminUser is the administrator of the registry, whose password is generated by Aruba Cloud.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.AdminUserDto"
+                        }
+                    ]
+                },
+                "elasticIp": {
+                    "description": "ElasticIp is the Elastic IP exposing the registry. The registry is only reachable from the VPC without it.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "securityGroup": {
+                    "description": "SecurityGroup is the security group filtering the traffic to the registry.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "size": {
+                    "description": "Size is the plan of the registry, setting its storage and throughput.\nAllowed values: Small, Medium, Large.",
+                    "type": "string"
+                },
+                "subnet": {
+                    "description": "Subnet is the subnet of the VPC the registry is attached to.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "vpc": {
+                    "description": "Vpc is the VPC in which the registry is created.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.ContainerRegistryPropertiesResponseDto": {
+            "type": "object",
+            "properties": {
+                "adminUser": {
+                    "description": "AdminUser is the administrator of the registry, without its password.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.AdminUserDto"
+                        }
+                    ]
+                },
+                "elasticIp": {
+                    "description": "ElasticIp is the Elastic IP exposing the registry, if any.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "endpoint": {
+                    "description": "Endpoint is the hostname of the registry used by docker login and in image names, e.g. myregistry.cr.arubacloud.com.",
+                    "type": "string"
+                },
+                "linkedResources": {
+                    "description": "LinkedResources is a list of the resources linked to the registry, e.g. its subnet and Elastic IP.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.LinkedResourceResponseDto"
+                    }
+                },
+                "securityGroup": {
+                    "description": "SecurityGroup is the security group filtering the traffic to the registry.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "size": {
+                    "description": "Size is the plan of the registry.",
+                    "type": "string"
+                },
+                "subnet": {
+                    "description": "Subnet is the subnet the registry is attached to.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "vpc": {
+                    "description": "Vpc is the VPC of the registry.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.ContainerRegistryUpdatePropertiesDto": {
+            "type": "object",
+            "properties": {
+                "securityGroup": {
+                    "description": "SecurityGroup is the security group filtering the traffic to the registry.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "size": {
+                    "description": "Size is the plan the registry is moved to.\nAllowed values: Small, Medium, Large.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.DisableStatusInfoResponseDto": {
+            "type": "object",
+            "properties": {
+                "isDisabled": {
+                    "description": "IsDisabled indicates if the resource is disabled.",
+                    "type": "boolean"
+                },
+                "previousStatus": {
+                    "description": "PreviousStatus is the previous status of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.PreviousStatusResponseDto"
+                        }
+                    ]
+                },
+                "reasons": {
+                    "description": "Reasons is a list of reasons for the disabled status.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.FlattenedContainerRegistryListResponseDto": {
+            "type": "object",
+            "properties": {
+                "first": {
+                    "description": "First is the URI of the first page.",
+                    "type": "string"
+                },
+                "last": {
+                    "description": "Last is the URI of the last page.",
+                    "type": "string"
+                },
+                "next": {
+                    "description": "Next is the URI of the next page.",
+                    "type": "string"
+                },
+                "prev": {
+                    "description": "Prev is the URI of the previous page.",
+                    "type": "string"
+                },
+                "self": {
+                    "description": "Self is the URI of the current page.",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total is the total number of container registries.",
+                    "type": "integer"
+                },
+                "values": {
+                    "description": "Values is a list of flattened container registries.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.FlattenedContainerRegistryResponseDto"
+                    }
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.FlattenedContainerRegistryResponseDto": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Category is the category of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.CategoryResponseDto"
+                        }
+                    ]
+                },
+                "createdBy": {
+                    "description": "CreatedBy is the user who created the resource.",
+                    "type": "string"
+                },
+                "createdUser": {
+                    "description": "CreatedUser is the user who created the resource.",
+                    "type": "string"
+                },
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the resource.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the resource.",
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Location is the region where the resource is located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.LocationResponseDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name is the name of the resource.",
+                    "type": "string"
+                },
+                "project": {
+                    "description": "Project is the project where the resource belongs.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.ProjectResponseDto"
+                        }
+                    ]
+                },
+                "properties": {
+                    "description": "Properties contains the properties of the container registry.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.ContainerRegistryPropertiesResponseDto"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Status contains the status of the container registry.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.StatusResponseDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updateDate": {
+                    "description": "UpdateDate is the last update date of the resource.",
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "description": "UpdatedBy is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "updatedUser": {
+                    "description": "UpdatedUser is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "uri": {
+                    "description": "URI is the URI of the resource.",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the version of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.FlattenedCreateContainerRegistryRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for the container registry.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.ContainerRegistryPropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.FlattenedUpdateContainerRegistryRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for updating the container registry.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.ContainerRegistryUpdatePropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.LinkedResourceResponseDto": {
+            "type": "object",
+            "properties": {
+                "strictCorrelation": {
+                    "description": "StrictCorrelation indicates if the correlation is strict.",
+                    "type": "boolean"
+                },
+                "uri": {
+                    "description": "URI is the URI of the linked resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.LocationDto": {
+            "type": "object",
+            "properties": {
+                "value": {
+                    "description": "Value is the region where the resource will be located.\nAvailable regions at present: ITBG-Bergamo.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.LocationResponseDto": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "description": "City is the city of the region.",
+                    "type": "string"
+                },
+                "code": {
+                    "description": "Code is the code of the region.",
+                    "type": "string"
+                },
+                "country": {
+                    "description": "Country is the country of the region.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of the region.",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value is the value of the region.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.PreviousStatusResponseDto": {
+            "type": "object",
+            "properties": {
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the previous status.",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "State is the previous state of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.ProjectResponseDto": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID is the unique identifier of the project.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.ReferenceDto": {
+            "type": "object",
+            "properties": {
+                "uri": {
+                    "description": "URI is the URI of the referenced resource,\ne.g. /projects/\u003cPROJECT_ID\u003e/providers/Aruba.Network/vpcs/\u003cVPC_ID\u003e/subnets/\u003cSUBNET_ID\u003e.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.RegistryCredentialsResponseDto": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "description": "Password is the password of the admin user.",
+                    "type": "string"
+                },
+                "username": {
+                    "description": "Username is the name of the admin user.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.StatusResponseDto": {
+            "type": "object",
+            "properties": {
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the status.",
+                    "type": "string"
+                },
+                "disableStatusInfo": {
+                    "description": "DisableStatusInfo contains the information about the disabled status of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_containerregistry-plugin_handlers.DisableStatusInfoResponseDto"
+                        }
+                    ]
+                },
+                "failureReason": {
+                    "description": "FailureReason is the reason of the failure, if any.",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "State is the state of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_containerregistry-plugin_handlers.TypologyResponseDto": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID is the unique identifier of the typology.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of the typology.",
+                    "type": "string"
+                }
+            }
+        }
+    }
+}`
+
+// SwaggerInfo holds exported Swagger Info so clients can modify it
+var SwaggerInfo = &swag.Spec{
+	Version:          "1.0",
+	Host:             "localhost:8080",
+	BasePath:         "/",
+	Schemes:          []string{"http"},
+	Title:            "Aruba Cloud Container Registry Plugin API for Krateo Operator Generator (KOG)",
+	Description:      "Simple wrapper around Aruba Cloud API to provide consistency of API response for Krateo Operator Generator (KOG)",
+	InfoInstanceName: "swagger",
+	SwaggerTemplate:  docTemplate,
+	LeftDelim:        "{{",
+	RightDelim:       "}}",
+}
+
+func init() {
+	swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+}
@@ -0,0 +1,211 @@
+package containerregistry
+
+import (
+	"errors"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// ContainerRegistry is the Aruba Cloud private container registry resource
+var ContainerRegistry = handlers.Resource{
+	Name:          "container registry",
+	Plural:        "container registries",
+	Path:          "/projects/{projectId}/providers/Aruba.Container/registries",
+	PathParams:    []handlers.Param{handlers.ProjectIDParam},
+	IDParam:       handlers.Param{Name: "id", Label: "Container Registry ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetContainerRegistry
+// @Summary Get a container registry from Aruba Cloud
+// @Description Get a container registry from Aruba Cloud using the provided project and container registry details.
+// @Description The state of the registry is reported in status.state, e.g. InCreation while it is being provisioned and Active once it can be used.
+// @Description The credentials of the admin user are never part of the response, they are served by the credentials endpoint.
+// @ID get-container-registry
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Container Registry ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedContainerRegistryResponseDto "Container registry details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/registries/{id} [get]
+func GetContainerRegistry(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[ContainerRegistryResponseDto](opts, ContainerRegistry)
+}
+
+// PostContainerRegistry
+// @Summary Create a new container registry on Aruba Cloud
+// @Description Create a new container registry on Aruba Cloud using the provided project details.
+// @Description The password of the admin user is generated by Aruba Cloud and served by the credentials endpoint.
+// @ID post-container-registry
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param containerRegistryCreate body FlattenedCreateContainerRegistryRequestDto true "Container registry creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedContainerRegistryResponseDto "Container registry details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/registries [post]
+func PostContainerRegistry(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateContainerRegistryRequestDto, ContainerRegistryDto, ContainerRegistryResponseDto](opts, ContainerRegistry,
+		func(req FlattenedCreateContainerRegistryRequestDto) ContainerRegistryDto {
+			return ContainerRegistryDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutContainerRegistry
+// @Summary Update a container registry on Aruba Cloud
+// @Description Update a container registry on Aruba Cloud using the provided project and container registry details.
+// @Description Only the size and the security group of a container registry can be changed, its network and admin user are fixed at creation.
+// @ID put-container-registry
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Container Registry ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param containerRegistryUpdate body FlattenedUpdateContainerRegistryRequestDto true "Container registry update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedContainerRegistryResponseDto "Container registry details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/registries/{id} [put]
+func PutContainerRegistry(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateContainerRegistryRequestDto, ContainerRegistryUpdateDto, ContainerRegistryResponseDto](opts, ContainerRegistry,
+		func(req FlattenedUpdateContainerRegistryRequestDto) ContainerRegistryUpdateDto {
+			return ContainerRegistryUpdateDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListContainerRegistries
+// @Summary List container registries on Aruba Cloud
+// @Description List container registries on Aruba Cloud using the provided project details.
+// @ID list-container-registries
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedContainerRegistryListResponseDto "A list of container registries"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/registries [get]
+func ListContainerRegistries(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[ContainerRegistryResponseDto, FlattenedContainerRegistryResponseDto](opts, ContainerRegistry)
+}
+
+// DeleteContainerRegistry
+// @Summary Delete a container registry on Aruba Cloud
+// @Description Delete a container registry on Aruba Cloud using the provided project and container registry details.
+// @Description Deleting a container registry that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-container-registry
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Container Registry ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/registries/{id} [delete]
+func DeleteContainerRegistry(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, ContainerRegistry)
+}
+
+// GetContainerRegistryCredentials
+// @Summary Get the credentials of a container registry from Aruba Cloud
+// @Description Get the credentials of the admin user of a container registry from Aruba Cloud using the provided project and container registry details.
+// @Description The credentials are only served by this endpoint: they are redacted from the other responses and never logged.
+// @ID get-container-registry-credentials
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Container Registry ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} RegistryCredentialsResponseDto "Credentials of the container registry"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/registries/{id}/credentials [get]
+func GetContainerRegistryCredentials(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Subresource[RegistryCredentialsResponseDto](opts, ContainerRegistry, "credentials", "credentials")
+}
+
+// Sizes of a container registry
+const (
+	SizeSmall  = "Small"
+	SizeMedium = "Medium"
+	SizeLarge  = "Large"
+)
+
+// Validate checks the size, the network and the admin user of the container registry before it is sent to Aruba Cloud
+func (req FlattenedCreateContainerRegistryRequestDto) Validate() error {
+	props := req.Properties
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if err := validation.OneOf("properties.size", props.Size, SizeSmall, SizeMedium, SizeLarge); err != nil {
+		return err
+	}
+	if props.Vpc == nil || props.Vpc.URI == "" {
+		return errors.New("properties.vpc.uri is required")
+	}
+	if props.Subnet == nil || props.Subnet.URI == "" {
+		return errors.New("properties.subnet.uri is required")
+	}
+	if props.AdminUser == nil || props.AdminUser.Username == "" {
+		return errors.New("properties.adminUser.username is required")
+	}
+	return nil
+}
+
+// Validate checks the size of the container registry before it is sent to Aruba Cloud
+func (req FlattenedUpdateContainerRegistryRequestDto) Validate() error {
+	if req.Properties == nil || req.Properties.Size == "" {
+		return nil
+	}
+	return validation.OneOf("properties.size", req.Properties.Size, SizeSmall, SizeMedium, SizeLarge)
+}
@@ -0,0 +1,158 @@
+package containerregistry
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// newTestMux serves the container registry handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	mux := http.NewServeMux()
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Container/registries", ListContainerRegistries(opts))
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Container/registries/{id}", GetContainerRegistry(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Container/registries", PostContainerRegistry(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Container/registries/{id}", PutContainerRegistry(opts))
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Container/registries/{id}/credentials", GetContainerRegistryCredentials(opts))
+	return mux, calls
+}
+
+// TestContainerRegistryHandlers tests that the password of the admin user is only returned by the credentials endpoint
+func TestContainerRegistryHandlers(t *testing.T) {
+	const registriesURI = "/projects/p1/providers/Aruba.Container/registries"
+	// Aruba Cloud returns the password of the admin user along with the registry
+	registry := `{"metadata":{"id":"cr1","name":"images"},"status":{"state":"Active"},"properties":{"size":"Small","adminUser":{"username":"admin","password":"s3cret"},"endpoint":"images.cr.arubacloud.com"}}`
+	flattened := `{"id":"cr1","name":"images","properties":{"adminUser":{"username":"admin"},"endpoint":"images.cr.arubacloud.com","size":"Small"},"status":{"state":"Active"}}`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		upstreamStatus int
+		upstreamBody   string
+		expectedCall   handlertest.Call
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "get leaves out the password",
+			method:         http.MethodGet,
+			target:         registriesURI + "/cr1?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   registry,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: registriesURI + "/cr1?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "list leaves out the password",
+			method:         http.MethodGet,
+			target:         registriesURI + "?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"total":1,"values":[` + registry + `]}`,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: registriesURI + "?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"total":1,"values":[{"id":"cr1","name":"images","status":{"state":"Active"},"properties":{"size":"Small","adminUser":{"username":"admin"},"endpoint":"images.cr.arubacloud.com"}}]}`,
+		},
+		{
+			name:           "create leaves out the password",
+			method:         http.MethodPost,
+			target:         registriesURI + "?api-version=1.0",
+			body:           `{"name":"images","properties":{"size":"Small","vpc":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1"},"subnet":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/subnets/s1"},"adminUser":{"username":"admin"}}}`,
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   registry,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: registriesURI + "?api-version=1.0", Body: `{"metadata":{"name":"images"},"properties":{"size":"Small","vpc":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1"},"subnet":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/subnets/s1"},"adminUser":{"username":"admin"}}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "update leaves out the password",
+			method:         http.MethodPut,
+			target:         registriesURI + "/cr1?api-version=1.0",
+			body:           `{"name":"images","properties":{"size":"Medium","adminUser":{"username":"root"}}}`,
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   registry,
+			expectedCall:   handlertest.Call{Method: http.MethodPut, URI: registriesURI + "/cr1?api-version=1.0", Body: `{"metadata":{"name":"images"},"properties":{"size":"Medium"}}`},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "credentials",
+			method:         http.MethodGet,
+			target:         registriesURI + "/cr1/credentials?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"username":"admin","password":"s3cret"}`,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: registriesURI + "/cr1/credentials?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"username":"admin","password":"s3cret"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.upstreamStatus)
+				w.Write([]byte(tc.upstreamBody))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 1 || (*calls)[0] != tc.expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", tc.expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tc.expectedBody {
+				t.Errorf("expected body '%s', got '%s'", tc.expectedBody, got)
+			}
+		})
+	}
+}
+
+// TestContainerRegistryHandlers_Validation tests that incomplete container registries are rejected before calling Aruba Cloud
+func TestContainerRegistryHandlers_Validation(t *testing.T) {
+	const registriesURI = "/projects/p1/providers/Aruba.Container/registries?api-version=1.0"
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedDetail string
+	}{
+		{name: "unknown size", method: http.MethodPost, target: registriesURI, body: `{"properties":{"size":"Huge"}}`, expectedDetail: `properties.size must be one of Small, Medium, Large, got 'Huge'`},
+		{name: "missing subnet", method: http.MethodPost, target: registriesURI, body: `{"properties":{"size":"Small","vpc":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1"}}}`, expectedDetail: "properties.subnet.uri is required"},
+		{name: "missing admin user", method: http.MethodPost, target: registriesURI, body: `{"properties":{"size":"Small","vpc":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1"},"subnet":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/subnets/s1"}}}`, expectedDetail: "properties.adminUser.username is required"},
+		{name: "unknown size on update", method: http.MethodPut, target: "/projects/p1/providers/Aruba.Container/registries/cr1?api-version=1.0", body: `{"properties":{"size":"Huge"}}`, expectedDetail: `properties.size must be one of Small, Medium, Large, got 'Huge'`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 0 {
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			}
+			if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+				t.Fatalf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+			}
+			var problem handlers.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
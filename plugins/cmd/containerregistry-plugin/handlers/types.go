@@ -0,0 +1,266 @@
+package containerregistry
+
+type ContainerRegistryDto struct {
+	Metadata   *MetadataDto                    `json:"metadata,omitempty"`
+	Properties *ContainerRegistryPropertiesDto `json:"properties,omitempty"`
+}
+
+type ContainerRegistryUpdateDto struct {
+	Metadata   *MetadataDto                          `json:"metadata,omitempty"`
+	Properties *ContainerRegistryUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+type MetadataDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+}
+
+type LocationDto struct {
+	// Value is the region where the resource will be located.
+	// Available regions at present: ITBG-Bergamo.
+	Value string `json:"value,omitempty"`
+}
+
+type ContainerRegistryPropertiesDto struct {
+	// Size is the plan of the registry, setting its storage and throughput.
+	// Allowed values: Small, Medium, Large.
+	Size string `json:"size,omitempty"`
+	// Vpc is the VPC in which the registry is created.
+	Vpc *ReferenceDto `json:"vpc,omitempty"`
+	// Subnet is the subnet of the VPC the registry is attached to.
+	Subnet *ReferenceDto `json:"subnet,omitempty"`
+	// SecurityGroup is the security group filtering the traffic to the registry.
+	SecurityGroup *ReferenceDto `json:"securityGroup,omitempty"`
+	// ElasticIp is the Elastic IP exposing the registry. The registry is only reachable from the VPC without it.
+	ElasticIp *ReferenceDto `json:"elasticIp,omitempty"`
+	// AdminUser is the administrator of the registry, whose password is generated by Aruba Cloud.
+	AdminUser *AdminUserDto `json:"adminUser,omitempty"`
+}
+
+type ContainerRegistryUpdatePropertiesDto struct {
+	// Size is the plan the registry is moved to.
+	// Allowed values: Small, Medium, Large.
+	Size string `json:"size,omitempty"`
+	// SecurityGroup is the security group filtering the traffic to the registry.
+	SecurityGroup *ReferenceDto `json:"securityGroup,omitempty"`
+}
+
+type AdminUserDto struct {
+	// Username is the name of the administrator of the registry.
+	Username string `json:"username,omitempty"`
+}
+
+type ReferenceDto struct {
+	// URI is the URI of the referenced resource,
+	// e.g. /projects/<PROJECT_ID>/providers/Aruba.Network/vpcs/<VPC_ID>/subnets/<SUBNET_ID>.
+	URI string `json:"uri,omitempty"`
+}
+
+type ContainerRegistryResponseDto struct {
+	Metadata   *MetadataResponseDto                    `json:"metadata,omitempty"`
+	Status     *StatusResponseDto                      `json:"status,omitempty"`
+	Properties *ContainerRegistryPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type MetadataResponseDto struct {
+	ID           string               `json:"id,omitempty"`
+	URI          string               `json:"uri,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	Location     *LocationResponseDto `json:"location,omitempty"`
+	Project      *ProjectResponseDto  `json:"project,omitempty"`
+	Tags         []string             `json:"tags,omitempty"`
+	Category     *CategoryResponseDto `json:"category,omitempty"`
+	CreationDate string               `json:"creationDate,omitempty"`
+	CreatedBy    string               `json:"createdBy,omitempty"`
+	UpdateDate   string               `json:"updateDate,omitempty"`
+	UpdatedBy    string               `json:"updatedBy,omitempty"`
+	Version      string               `json:"version,omitempty"`
+	CreatedUser  string               `json:"createdUser,omitempty"`
+	UpdatedUser  string               `json:"updatedUser,omitempty"`
+}
+
+type LocationResponseDto struct {
+	// Code is the code of the region.
+	Code string `json:"code,omitempty"`
+	// Country is the country of the region.
+	Country string `json:"country,omitempty"`
+	// City is the city of the region.
+	City string `json:"city,omitempty"`
+	// Name is the name of the region.
+	Name string `json:"name,omitempty"`
+	// Value is the value of the region.
+	Value string `json:"value,omitempty"`
+}
+
+type ProjectResponseDto struct {
+	// ID is the unique identifier of the project.
+	ID string `json:"id,omitempty"`
+}
+
+type CategoryResponseDto struct {
+	// Name is the name of the category.
+	Name string `json:"name,omitempty"`
+	// Provider is the provider of the category.
+	Provider string `json:"provider,omitempty"`
+	// Typology is the typology of the category.
+	Typology *TypologyResponseDto `json:"typology,omitempty"`
+}
+
+type TypologyResponseDto struct {
+	// ID is the unique identifier of the typology.
+	ID string `json:"id,omitempty"`
+	// Name is the name of the typology.
+	Name string `json:"name,omitempty"`
+}
+
+type StatusResponseDto struct {
+	// State is the state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the status.
+	CreationDate string `json:"creationDate,omitempty"`
+	// DisableStatusInfo contains the information about the disabled status of the resource.
+	DisableStatusInfo *DisableStatusInfoResponseDto `json:"disableStatusInfo,omitempty"`
+	// FailureReason is the reason of the failure, if any.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+type DisableStatusInfoResponseDto struct {
+	// IsDisabled indicates if the resource is disabled.
+	IsDisabled bool `json:"isDisabled,omitempty"`
+	// Reasons is a list of reasons for the disabled status.
+	Reasons []string `json:"reasons,omitempty"`
+	// PreviousStatus is the previous status of the resource.
+	PreviousStatus *PreviousStatusResponseDto `json:"previousStatus,omitempty"`
+}
+
+type PreviousStatusResponseDto struct {
+	// State is the previous state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the previous status.
+	CreationDate string `json:"creationDate,omitempty"`
+}
+
+// ContainerRegistryPropertiesResponseDto are the properties of a container registry returned by Aruba Cloud.
+// The credentials of the admin user are left out, so that they are only served by the credentials endpoint.
+type ContainerRegistryPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the registry, e.g. its subnet and Elastic IP.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Size is the plan of the registry.
+	Size string `json:"size,omitempty"`
+	// Vpc is the VPC of the registry.
+	Vpc *ReferenceDto `json:"vpc,omitempty"`
+	// Subnet is the subnet the registry is attached to.
+	Subnet *ReferenceDto `json:"subnet,omitempty"`
+	// SecurityGroup is the security group filtering the traffic to the registry.
+	SecurityGroup *ReferenceDto `json:"securityGroup,omitempty"`
+	// ElasticIp is the Elastic IP exposing the registry, if any.
+	ElasticIp *ReferenceDto `json:"elasticIp,omitempty"`
+	// AdminUser is the administrator of the registry, without its password.
+	AdminUser *AdminUserDto `json:"adminUser,omitempty"`
+	// Endpoint is the hostname of the registry used by docker login and in image names, e.g. myregistry.cr.arubacloud.com.
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+type LinkedResourceResponseDto struct {
+	// URI is the URI of the linked resource.
+	URI string `json:"uri,omitempty"`
+	// StrictCorrelation indicates if the correlation is strict.
+	StrictCorrelation bool `json:"strictCorrelation,omitempty"`
+}
+
+// --------------------------------------------------------------------------
+// Flattened types
+// --------------------------------------------------------------------------
+
+// FlattenedCreateContainerRegistryRequestDto is the flattened request body for creating a container registry.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateContainerRegistryRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the container registry.
+	Properties *ContainerRegistryPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateContainerRegistryRequestDto is the flattened request body for updating a container registry.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateContainerRegistryRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the container registry.
+	Properties *ContainerRegistryUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedContainerRegistryResponseDto is the flattened response body for a single container registry.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedContainerRegistryResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the container registry.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the container registry.
+	Properties *ContainerRegistryPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedContainerRegistryListResponseDto struct {
+	// Total is the total number of container registries.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened container registries.
+	Values []FlattenedContainerRegistryResponseDto `json:"values,omitempty"`
+}
+
+// RegistryCredentialsResponseDto are the credentials of the admin user of a container registry,
+// returned as is by the credentials endpoint
+type RegistryCredentialsResponseDto struct {
+	// Username is the name of the admin user.
+	Username string `json:"username,omitempty"`
+	// Password is the password of the admin user.
+	Password string `json:"password,omitempty"`
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	containerregistry "github.com/krateoplatformops/arubacloud-provider-kog/containerregistry-plugin/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/health"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/server"
+	httpSwagger "github.com/swaggo/http-swagger"
+)
+
+// @title           Aruba Cloud Container Registry Plugin API for Krateo Operator Generator (KOG)
+// @version         1.0
+// @description     Simple wrapper around Aruba Cloud API to provide consistency of API response for Krateo Operator Generator (KOG)
+// @termsOfService  http://swagger.io/terms/
+// @contact.name    Krateo Support
+// @contact.url     https://krateo.io
+// @contact.email   contact@krateoplatformops.io
+// @license.name    Apache 2.0
+// @license.url     http://www.apache.org/licenses/LICENSE-2.0.html
+// @host            localhost:8080
+// @BasePath        /
+// @schemes         http
+func main() {
+	srv := server.New()
+
+	opts := handlers.HandlerOptions{
+		Log:      srv.Logger(),
+		Client:   srv.HTTPClient(),
+		BaseURL:  srv.BaseURL(),
+		Auth:     srv.TokenProvider(),
+		Timeouts: srv.UpstreamTimeouts(),
+	}
+
+	// Container registry
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Container/registries", containerregistry.PostContainerRegistry(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Container/registries", containerregistry.ListContainerRegistries(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Container/registries/{id}", containerregistry.GetContainerRegistry(opts))
+	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Container/registries/{id}", containerregistry.PutContainerRegistry(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Container/registries/{id}", containerregistry.DeleteContainerRegistry(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Container/registries/{id}/credentials", containerregistry.GetContainerRegistryCredentials(opts))
+
+	// Swagger UI
+	srv.Mux().Handle("/swagger/", httpSwagger.WrapHandler)
+
+	// Kubernetes health check endpoints
+	srv.Mux().HandleFunc("GET /healthz", health.LivenessHandler(srv.Healthy()))
+	srv.Mux().HandleFunc("GET /readyz", srv.ReadinessHandler())
+
+	srv.Run()
+}
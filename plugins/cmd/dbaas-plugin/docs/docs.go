@@ -0,0 +1,2339 @@
+// Package docs Code generated by swaggo/swag. DO NOT EDIT
+package docs
+
+import "github.com/swaggo/swag"
+
+const docTemplate = `{
+    "schemes": {{ marshal .Schemes }},
+    "swagger": "2.0",
+    "info": {
+        "description": "{{escape .Description}}",
+        "title": "{{.Title}}",
+        "termsOfService": "http://swagger.io/terms/",
+        "contact": {
+            "name": "Krateo Support",
+            "url": "https://krateo.io",
+            "email": "contact@krateoplatformops.io"
+        },
+        "license": {
+            "name": "Apache 2.0",
+            "url": "http://www.apache.org/licenses/LICENSE-2.0.html"
+        },
+        "version": "{{.Version}}"
+    },
+    "host": "{{.Host}}",
+    "basePath": "{{.BasePath}}",
+    "paths": {
+        "/projects/{projectId}/providers/Aruba.Database/dbaas": {
+            "get": {
+                "description": "List DBaaS instances on Aruba Cloud using the provided project details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List DBaaS instances on Aruba Cloud",
+                "operationId": "list-dbaas-instances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort expression",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Projection expression",
+                        "name": "projection",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A list of DBaaS instances",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedDbaasInstanceListResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new DBaaS instance on Aruba Cloud using the provided project details.\nThe password of the administrator is read from the Kubernetes Secret referenced by properties.adminUser.passwordSecretRef.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create a new DBaaS instance on Aruba Cloud",
+                "operationId": "post-dbaas-instance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "DBaaS instance creation request body",
+                        "name": "dbaasInstanceCreate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedCreateDbaasInstanceRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "DBaaS instance details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedDbaasInstanceResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/databases": {
+            "get": {
+                "description": "List databases on Aruba Cloud using the provided project and DBaaS instance details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List databases on Aruba Cloud",
+                "operationId": "list-databases",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DBaaS Instance ID",
+                        "name": "dbaasId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort expression",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Projection expression",
+                        "name": "projection",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A list of databases",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedDatabaseListResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new database on Aruba Cloud using the provided project and DBaaS instance details.\nDatabases cannot be updated, a different character set requires a new database.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create a new database on Aruba Cloud",
+                "operationId": "post-database",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DBaaS Instance ID",
+                        "name": "dbaasId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Database creation request body",
+                        "name": "databaseCreate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedCreateDatabaseRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Database details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedDatabaseResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/databases/{id}": {
+            "get": {
+                "description": "Get a database from Aruba Cloud using the provided project, DBaaS instance and database details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a database from Aruba Cloud",
+                "operationId": "get-database",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DBaaS Instance ID",
+                        "name": "dbaasId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Database ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag",
+                        "name": "ignoreDeletedStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Database details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedDatabaseResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a database on Aruba Cloud using the provided project, DBaaS instance and database details.\nDeleting a database that does not exist or is already in 'Deleted' state is considered successful.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete a database on Aruba Cloud",
+                "operationId": "delete-database",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DBaaS Instance ID",
+                        "name": "dbaasId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Database ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users": {
+            "get": {
+                "description": "List database users on Aruba Cloud using the provided project and DBaaS instance details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "List database users on Aruba Cloud",
+                "operationId": "list-database-users",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DBaaS Instance ID",
+                        "name": "dbaasId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter expression",
+                        "name": "filter",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort expression",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Projection expression",
+                        "name": "projection",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "A list of database users",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedDatabaseUserListResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new database user on Aruba Cloud using the provided project and DBaaS instance details.\nThe password of the user is read from the Kubernetes Secret referenced by properties.passwordSecretRef.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Create a new database user on Aruba Cloud",
+                "operationId": "post-database-user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DBaaS Instance ID",
+                        "name": "dbaasId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Database user creation request body",
+                        "name": "databaseUserCreate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedCreateDatabaseUserRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Database user details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedDatabaseUserResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users/{id}": {
+            "get": {
+                "description": "Get a database user from Aruba Cloud using the provided project, DBaaS instance and database user details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a database user from Aruba Cloud",
+                "operationId": "get-database-user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DBaaS Instance ID",
+                        "name": "dbaasId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Database User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag",
+                        "name": "ignoreDeletedStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Database user details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedDatabaseUserResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a database user on Aruba Cloud using the provided project, DBaaS instance and database user details.\nThe password is changed when properties.passwordSecretRef is set, the grants replace the current ones.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Update a database user on Aruba Cloud",
+                "operationId": "put-database-user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DBaaS Instance ID",
+                        "name": "dbaasId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Database User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Database user update request body",
+                        "name": "databaseUserUpdate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedUpdateDatabaseUserRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Database user details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedDatabaseUserResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a database user on Aruba Cloud using the provided project, DBaaS instance and database user details.\nDeleting a database user that does not exist or is already in 'Deleted' state is considered successful.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete a database user on Aruba Cloud",
+                "operationId": "delete-database-user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DBaaS Instance ID",
+                        "name": "dbaasId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Database User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        },
+        "/projects/{projectId}/providers/Aruba.Database/dbaas/{id}": {
+            "get": {
+                "description": "Get a DBaaS instance from Aruba Cloud using the provided project and DBaaS instance details.\nThe state of the instance is reported in status.state, e.g. InCreation while it is being provisioned and Active once it can be used.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Get a DBaaS instance from Aruba Cloud",
+                "operationId": "get-dbaas-instance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DBaaS Instance ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag",
+                        "name": "ignoreDeletedStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "DBaaS instance details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedDbaasInstanceResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a DBaaS instance on Aruba Cloud using the provided project and DBaaS instance details.\nOnly the flavor and the storage size of a DBaaS instance can be changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Update a DBaaS instance on Aruba Cloud",
+                "operationId": "put-dbaas-instance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DBaaS Instance ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    },
+                    {
+                        "description": "DBaaS instance update request body",
+                        "name": "dbaasInstanceUpdate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedUpdateDbaasInstanceRequestDto"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "DBaaS instance details",
+                        "schema": {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedDbaasInstanceResponseDto"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a DBaaS instance on Aruba Cloud using the provided project and DBaaS instance details.\nDeleting a DBaaS instance that does not exist or is already in 'Deleted' state is considered successful.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "summary": "Delete a DBaaS instance on Aruba Cloud",
+                "operationId": "delete-dbaas-instance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Project ID",
+                        "name": "projectId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "DBaaS Instance ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "API version (e.g., 1.0)",
+                        "name": "api-version",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bearer Token (Bearer \u003ctoken\u003e), optional when the plugin is configured with client credentials",
+                        "name": "Authorization",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    },
+                    "504": {
+                        "description": "Gateway Timeout",
+                        "schema": {
+                            "$ref": "#/definitions/ProblemDetails"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "ProblemDetails": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "description": "Detail is a human-readable explanation of the error.",
+                    "type": "string"
+                },
+                "instance": {
+                    "description": "Instance is the path of the request that caused the error.",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "Status is the HTTP status code of the response.",
+                    "type": "integer"
+                },
+                "title": {
+                    "description": "Title is a short summary of the error type.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type is a URI identifying the error type.",
+                    "type": "string"
+                },
+                "upstream": {
+                    "description": "Upstream is the original error body returned by Aruba Cloud, if any.",
+                    "type": "object"
+                }
+            }
+        },
+        "SecretRef": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "description": "Key of the value in the Secret.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name of the Secret.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace of the Secret.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.AdminUserRequestDto": {
+            "type": "object",
+            "properties": {
+                "passwordSecretRef": {
+                    "description": "PasswordSecretRef is the key of the Kubernetes Secret holding the password of the administrator.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/SecretRef"
+                        }
+                    ]
+                },
+                "username": {
+                    "description": "Username of the administrator.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.AdminUserResponseDto": {
+            "type": "object",
+            "properties": {
+                "username": {
+                    "description": "Username of the administrator.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.CategoryResponseDto": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name is the name of the category.",
+                    "type": "string"
+                },
+                "provider": {
+                    "description": "Provider is the provider of the category.",
+                    "type": "string"
+                },
+                "typology": {
+                    "description": "Typology is the typology of the category.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.TypologyResponseDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.DatabasePropertiesDto": {
+            "type": "object",
+            "properties": {
+                "charset": {
+                    "description": "Charset is the character set of the database, e.g. utf8mb4. The default of the engine is used if empty.",
+                    "type": "string"
+                },
+                "collation": {
+                    "description": "Collation is the collation of the database, e.g. utf8mb4_general_ci.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.DatabasePropertiesResponseDto": {
+            "type": "object",
+            "properties": {
+                "charset": {
+                    "description": "Charset is the character set of the database.",
+                    "type": "string"
+                },
+                "collation": {
+                    "description": "Collation is the collation of the database.",
+                    "type": "string"
+                },
+                "linkedResources": {
+                    "description": "LinkedResources is a list of the resources linked to the database, e.g. its users.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_dbaas-plugin_handlers.LinkedResourceResponseDto"
+                    }
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.DatabaseUserPropertiesResponseDto": {
+            "type": "object",
+            "properties": {
+                "grants": {
+                    "description": "Grants are the roles of the user on the databases of the instance.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_dbaas-plugin_handlers.GrantDto"
+                    }
+                },
+                "linkedResources": {
+                    "description": "LinkedResources is a list of the resources linked to the user, e.g. its databases.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_dbaas-plugin_handlers.LinkedResourceResponseDto"
+                    }
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.DatabaseUserRequestPropertiesDto": {
+            "type": "object",
+            "properties": {
+                "grants": {
+                    "description": "Grants are the roles of the user on the databases of the instance.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_dbaas-plugin_handlers.GrantDto"
+                    }
+                },
+                "passwordSecretRef": {
+                    "description": "PasswordSecretRef is the key of the Kubernetes Secret holding the password of the user.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/SecretRef"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.DbaasInstancePropertiesResponseDto": {
+            "type": "object",
+            "properties": {
+                "adminUser": {
+                    "description": "AdminUser is the administrator of the instance, without its password.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.AdminUserResponseDto"
+                        }
+                    ]
+                },
+                "elasticIp": {
+                    "description": "ElasticIp is the Elastic IP exposing the instance, if any.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "endpoint": {
+                    "description": "Endpoint is the address the clients connect to.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.EndpointDto"
+                        }
+                    ]
+                },
+                "engine": {
+                    "description": "Engine is the database engine of the instance.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.EngineDto"
+                        }
+                    ]
+                },
+                "flavorName": {
+                    "description": "FlavorName is the name of the flavor of the instance.",
+                    "type": "string"
+                },
+                "linkedResources": {
+                    "description": "LinkedResources is a list of the resources linked to the instance, e.g. its VPC and subnet.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_dbaas-plugin_handlers.LinkedResourceResponseDto"
+                    }
+                },
+                "securityGroup": {
+                    "description": "SecurityGroup is the security group applied to the instance.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "storage": {
+                    "description": "Storage is the storage of the instance.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.StorageDto"
+                        }
+                    ]
+                },
+                "subnet": {
+                    "description": "Subnet is the subnet the instance is attached to.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "vpc": {
+                    "description": "Vpc is the VPC of the instance.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "zone": {
+                    "description": "Zone is the availability zone of the instance.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.DbaasInstanceRequestPropertiesDto": {
+            "type": "object",
+            "properties": {
+                "adminUser": {
+                    "description": "AdminUser is the administrator of the instance.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.AdminUserRequestDto"
+                        }
+                    ]
+                },
+                "elasticIp": {
+                    "description": "ElasticIp is the Elastic IP exposing the instance, if any.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "engine": {
+                    "description": "Engine is the database engine of the instance.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.EngineDto"
+                        }
+                    ]
+                },
+                "flavorName": {
+                    "description": "FlavorName is the name of the flavor (CPU and RAM) of the instance, e.g. DBO2A4.",
+                    "type": "string"
+                },
+                "securityGroup": {
+                    "description": "SecurityGroup is the security group applied to the instance.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "storage": {
+                    "description": "Storage is the storage of the instance.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.StorageDto"
+                        }
+                    ]
+                },
+                "subnet": {
+                    "description": "Subnet is the subnet of the VPC the instance is attached to.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "vpc": {
+                    "description": "Vpc is the VPC in which the instance is created.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.ReferenceDto"
+                        }
+                    ]
+                },
+                "zone": {
+                    "description": "Zone is the availability zone of the instance, e.g. ITBG-1.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.DbaasInstanceUpdatePropertiesDto": {
+            "type": "object",
+            "properties": {
+                "flavorName": {
+                    "description": "FlavorName is the name of the flavor (CPU and RAM) of the instance.",
+                    "type": "string"
+                },
+                "storage": {
+                    "description": "Storage is the storage of the instance. It can only be extended.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.StorageDto"
+                        }
+                    ]
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.DisableStatusInfoResponseDto": {
+            "type": "object",
+            "properties": {
+                "isDisabled": {
+                    "description": "IsDisabled indicates if the resource is disabled.",
+                    "type": "boolean"
+                },
+                "previousStatus": {
+                    "description": "PreviousStatus is the previous status of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.PreviousStatusResponseDto"
+                        }
+                    ]
+                },
+                "reasons": {
+                    "description": "Reasons is a list of reasons for the disabled status.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.EndpointDto": {
+            "type": "object",
+            "properties": {
+                "host": {
+                    "description": "Host is the host name or IP address of the instance.",
+                    "type": "string"
+                },
+                "port": {
+                    "description": "Port is the port of the instance, e.g. 3306 for MySQL or 5432 for PostgreSQL.",
+                    "type": "integer"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.EngineDto": {
+            "type": "object",
+            "properties": {
+                "type": {
+                    "description": "Type is the database engine.\nAllowed values: MySQL, PostgreSQL.",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the version of the engine, e.g. 8.0 for MySQL or 16 for PostgreSQL.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.FlattenedCreateDatabaseRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for the database.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.DatabasePropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.FlattenedCreateDatabaseUserRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for the database user.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.DatabaseUserRequestPropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.FlattenedCreateDbaasInstanceRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for the DBaaS instance.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.DbaasInstanceRequestPropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.FlattenedDatabaseListResponseDto": {
+            "type": "object",
+            "properties": {
+                "first": {
+                    "description": "First is the URI of the first page.",
+                    "type": "string"
+                },
+                "last": {
+                    "description": "Last is the URI of the last page.",
+                    "type": "string"
+                },
+                "next": {
+                    "description": "Next is the URI of the next page.",
+                    "type": "string"
+                },
+                "prev": {
+                    "description": "Prev is the URI of the previous page.",
+                    "type": "string"
+                },
+                "self": {
+                    "description": "Self is the URI of the current page.",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total is the total number of databases.",
+                    "type": "integer"
+                },
+                "values": {
+                    "description": "Values is a list of flattened databases.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedDatabaseResponseDto"
+                    }
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.FlattenedDatabaseResponseDto": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Category is the category of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.CategoryResponseDto"
+                        }
+                    ]
+                },
+                "createdBy": {
+                    "description": "CreatedBy is the user who created the resource.",
+                    "type": "string"
+                },
+                "createdUser": {
+                    "description": "CreatedUser is the user who created the resource.",
+                    "type": "string"
+                },
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the resource.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the resource.",
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Location is the region where the resource is located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.LocationResponseDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name is the name of the resource.",
+                    "type": "string"
+                },
+                "project": {
+                    "description": "Project is the project where the resource belongs.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.ProjectResponseDto"
+                        }
+                    ]
+                },
+                "properties": {
+                    "description": "Properties contains the properties of the database.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.DatabasePropertiesResponseDto"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Status contains the status of the database.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.StatusResponseDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updateDate": {
+                    "description": "UpdateDate is the last update date of the resource.",
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "description": "UpdatedBy is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "updatedUser": {
+                    "description": "UpdatedUser is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "uri": {
+                    "description": "URI is the URI of the resource.",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the version of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.FlattenedDatabaseUserListResponseDto": {
+            "type": "object",
+            "properties": {
+                "first": {
+                    "description": "First is the URI of the first page.",
+                    "type": "string"
+                },
+                "last": {
+                    "description": "Last is the URI of the last page.",
+                    "type": "string"
+                },
+                "next": {
+                    "description": "Next is the URI of the next page.",
+                    "type": "string"
+                },
+                "prev": {
+                    "description": "Prev is the URI of the previous page.",
+                    "type": "string"
+                },
+                "self": {
+                    "description": "Self is the URI of the current page.",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total is the total number of database users.",
+                    "type": "integer"
+                },
+                "values": {
+                    "description": "Values is a list of flattened database users.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedDatabaseUserResponseDto"
+                    }
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.FlattenedDatabaseUserResponseDto": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Category is the category of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.CategoryResponseDto"
+                        }
+                    ]
+                },
+                "createdBy": {
+                    "description": "CreatedBy is the user who created the resource.",
+                    "type": "string"
+                },
+                "createdUser": {
+                    "description": "CreatedUser is the user who created the resource.",
+                    "type": "string"
+                },
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the resource.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the resource.",
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Location is the region where the resource is located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.LocationResponseDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name is the name of the resource.",
+                    "type": "string"
+                },
+                "project": {
+                    "description": "Project is the project where the resource belongs.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.ProjectResponseDto"
+                        }
+                    ]
+                },
+                "properties": {
+                    "description": "Properties contains the properties of the database user.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.DatabaseUserPropertiesResponseDto"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Status contains the status of the database user.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.StatusResponseDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updateDate": {
+                    "description": "UpdateDate is the last update date of the resource.",
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "description": "UpdatedBy is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "updatedUser": {
+                    "description": "UpdatedUser is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "uri": {
+                    "description": "URI is the URI of the resource.",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the version of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.FlattenedDbaasInstanceListResponseDto": {
+            "type": "object",
+            "properties": {
+                "first": {
+                    "description": "First is the URI of the first page.",
+                    "type": "string"
+                },
+                "last": {
+                    "description": "Last is the URI of the last page.",
+                    "type": "string"
+                },
+                "next": {
+                    "description": "Next is the URI of the next page.",
+                    "type": "string"
+                },
+                "prev": {
+                    "description": "Prev is the URI of the previous page.",
+                    "type": "string"
+                },
+                "self": {
+                    "description": "Self is the URI of the current page.",
+                    "type": "string"
+                },
+                "total": {
+                    "description": "Total is the total number of DBaaS instances.",
+                    "type": "integer"
+                },
+                "values": {
+                    "description": "Values is a list of flattened DBaaS instances.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cmd_dbaas-plugin_handlers.FlattenedDbaasInstanceResponseDto"
+                    }
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.FlattenedDbaasInstanceResponseDto": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Category is the category of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.CategoryResponseDto"
+                        }
+                    ]
+                },
+                "createdBy": {
+                    "description": "CreatedBy is the user who created the resource.",
+                    "type": "string"
+                },
+                "createdUser": {
+                    "description": "CreatedUser is the user who created the resource.",
+                    "type": "string"
+                },
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the resource.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID is the unique identifier of the resource.",
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Location is the region where the resource is located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.LocationResponseDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name is the name of the resource.",
+                    "type": "string"
+                },
+                "project": {
+                    "description": "Project is the project where the resource belongs.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.ProjectResponseDto"
+                        }
+                    ]
+                },
+                "properties": {
+                    "description": "Properties contains the properties of the DBaaS instance.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.DbaasInstancePropertiesResponseDto"
+                        }
+                    ]
+                },
+                "status": {
+                    "description": "Status contains the status of the DBaaS instance.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.StatusResponseDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "updateDate": {
+                    "description": "UpdateDate is the last update date of the resource.",
+                    "type": "string"
+                },
+                "updatedBy": {
+                    "description": "UpdatedBy is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "updatedUser": {
+                    "description": "UpdatedUser is the user who last updated the resource.",
+                    "type": "string"
+                },
+                "uri": {
+                    "description": "URI is the URI of the resource.",
+                    "type": "string"
+                },
+                "version": {
+                    "description": "Version is the version of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.FlattenedUpdateDatabaseUserRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for updating the database user.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.DatabaseUserRequestPropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.FlattenedUpdateDbaasInstanceRequestDto": {
+            "type": "object",
+            "properties": {
+                "location": {
+                    "description": "Location is the region where the resource will be located.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.LocationDto"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name of the resource.",
+                    "type": "string"
+                },
+                "properties": {
+                    "description": "Properties contains the properties for updating the DBaaS instance.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.DbaasInstanceUpdatePropertiesDto"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags is a list of tags for the resource.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.GrantDto": {
+            "type": "object",
+            "properties": {
+                "database": {
+                    "description": "Database is the name of the database.",
+                    "type": "string"
+                },
+                "role": {
+                    "description": "Role is the role of the user on the database.\nAllowed values: ReadOnly, ReadWrite, Owner.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.LinkedResourceResponseDto": {
+            "type": "object",
+            "properties": {
+                "strictCorrelation": {
+                    "description": "StrictCorrelation indicates if the correlation is strict.",
+                    "type": "boolean"
+                },
+                "uri": {
+                    "description": "URI is the URI of the linked resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.LocationDto": {
+            "type": "object",
+            "properties": {
+                "value": {
+                    "description": "Value is the region where the resource will be located.\nAvailable regions at present: ITBG-Bergamo.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.LocationResponseDto": {
+            "type": "object",
+            "properties": {
+                "city": {
+                    "description": "City is the city of the region.",
+                    "type": "string"
+                },
+                "code": {
+                    "description": "Code is the code of the region.",
+                    "type": "string"
+                },
+                "country": {
+                    "description": "Country is the country of the region.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of the region.",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value is the value of the region.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.PreviousStatusResponseDto": {
+            "type": "object",
+            "properties": {
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the previous status.",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "State is the previous state of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.ProjectResponseDto": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID is the unique identifier of the project.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.ReferenceDto": {
+            "type": "object",
+            "properties": {
+                "uri": {
+                    "description": "URI is the URI of the referenced resource,\ne.g. /projects/\u003cPROJECT_ID\u003e/providers/Aruba.Network/vpcs/\u003cVPC_ID\u003e/subnets/\u003cSUBNET_ID\u003e.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.StatusResponseDto": {
+            "type": "object",
+            "properties": {
+                "creationDate": {
+                    "description": "CreationDate is the creation date of the status.",
+                    "type": "string"
+                },
+                "disableStatusInfo": {
+                    "description": "DisableStatusInfo contains the information about the disabled status of the resource.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/cmd_dbaas-plugin_handlers.DisableStatusInfoResponseDto"
+                        }
+                    ]
+                },
+                "failureReason": {
+                    "description": "FailureReason is the reason of the failure, if any.",
+                    "type": "string"
+                },
+                "state": {
+                    "description": "State is the state of the resource.",
+                    "type": "string"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.StorageDto": {
+            "type": "object",
+            "properties": {
+                "sizeGb": {
+                    "description": "SizeGb is the size of the storage in GB.",
+                    "type": "integer"
+                }
+            }
+        },
+        "cmd_dbaas-plugin_handlers.TypologyResponseDto": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID is the unique identifier of the typology.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of the typology.",
+                    "type": "string"
+                }
+            }
+        }
+    }
+}`
+
+// SwaggerInfo holds exported Swagger Info so clients can modify it
+var SwaggerInfo = &swag.Spec{
+	Version:          "1.0",
+	Host:             "localhost:8080",
+	BasePath:         "/",
+	Schemes:          []string{"http"},
+	Title:            "Aruba Cloud DBaaS Plugin API for Krateo Operator Generator (KOG)",
+	Description:      "Simple wrapper around Aruba Cloud API to provide consistency of API response for Krateo Operator Generator (KOG)",
+	InfoInstanceName: "swagger",
+	SwaggerTemplate:  docTemplate,
+	LeftDelim:        "{{",
+	RightDelim:       "}}",
+}
+
+func init() {
+	swag.Register(SwaggerInfo.InstanceName(), SwaggerInfo)
+}
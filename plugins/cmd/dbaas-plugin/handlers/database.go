@@ -0,0 +1,122 @@
+package dbaas
+
+import (
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+)
+
+// Database is the Aruba Cloud database resource of a DBaaS instance
+var Database = handlers.Resource{
+	Name:   "database",
+	Plural: "databases",
+	Path:   "/projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/databases",
+	PathParams: []handlers.Param{
+		handlers.ProjectIDParam,
+		{Name: "dbaasId", Label: "DBaaS Instance ID"},
+	},
+	IDParam:       handlers.Param{Name: "id", Label: "Database ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetDatabase
+// @Summary Get a database from Aruba Cloud
+// @Description Get a database from Aruba Cloud using the provided project, DBaaS instance and database details.
+// @ID get-database
+// @Param projectId path string true "Project ID"
+// @Param dbaasId path string true "DBaaS Instance ID"
+// @Param id path string true "Database ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedDatabaseResponseDto "Database details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/databases/{id} [get]
+func GetDatabase(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[DatabaseResponseDto](opts, Database)
+}
+
+// PostDatabase
+// @Summary Create a new database on Aruba Cloud
+// @Description Create a new database on Aruba Cloud using the provided project and DBaaS instance details.
+// @Description Databases cannot be updated, a different character set requires a new database.
+// @ID post-database
+// @Param projectId path string true "Project ID"
+// @Param dbaasId path string true "DBaaS Instance ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param databaseCreate body FlattenedCreateDatabaseRequestDto true "Database creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedDatabaseResponseDto "Database details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/databases [post]
+func PostDatabase(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateDatabaseRequestDto, DatabaseDto, DatabaseResponseDto](opts, Database,
+		func(req FlattenedCreateDatabaseRequestDto) DatabaseDto {
+			return DatabaseDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListDatabases
+// @Summary List databases on Aruba Cloud
+// @Description List databases on Aruba Cloud using the provided project and DBaaS instance details.
+// @ID list-databases
+// @Param projectId path string true "Project ID"
+// @Param dbaasId path string true "DBaaS Instance ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedDatabaseListResponseDto "A list of databases"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/databases [get]
+func ListDatabases(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[DatabaseResponseDto, FlattenedDatabaseResponseDto](opts, Database)
+}
+
+// DeleteDatabase
+// @Summary Delete a database on Aruba Cloud
+// @Description Delete a database on Aruba Cloud using the provided project, DBaaS instance and database details.
+// @Description Deleting a database that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-database
+// @Param projectId path string true "Project ID"
+// @Param dbaasId path string true "DBaaS Instance ID"
+// @Param id path string true "Database ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/databases/{id} [delete]
+func DeleteDatabase(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, Database)
+}
@@ -0,0 +1,238 @@
+package dbaas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/secrets"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// DbaasInstance is the Aruba Cloud managed database (DBaaS) instance resource
+var DbaasInstance = handlers.Resource{
+	Name:          "DBaaS instance",
+	Plural:        "DBaaS instances",
+	Path:          "/projects/{projectId}/providers/Aruba.Database/dbaas",
+	PathParams:    []handlers.Param{handlers.ProjectIDParam},
+	IDParam:       handlers.Param{Name: "id", Label: "DBaaS Instance ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetDbaasInstance
+// @Summary Get a DBaaS instance from Aruba Cloud
+// @Description Get a DBaaS instance from Aruba Cloud using the provided project and DBaaS instance details.
+// @Description The state of the instance is reported in status.state, e.g. InCreation while it is being provisioned and Active once it can be used.
+// @ID get-dbaas-instance
+// @Param projectId path string true "Project ID"
+// @Param id path string true "DBaaS Instance ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedDbaasInstanceResponseDto "DBaaS instance details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Database/dbaas/{id} [get]
+func GetDbaasInstance(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[DbaasInstanceResponseDto](opts, DbaasInstance)
+}
+
+// PostDbaasInstance
+// @Summary Create a new DBaaS instance on Aruba Cloud
+// @Description Create a new DBaaS instance on Aruba Cloud using the provided project details.
+// @Description The password of the administrator is read from the Kubernetes Secret referenced by properties.adminUser.passwordSecretRef.
+// @ID post-dbaas-instance
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param dbaasInstanceCreate body FlattenedCreateDbaasInstanceRequestDto true "DBaaS instance creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedDbaasInstanceResponseDto "DBaaS instance details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Database/dbaas [post]
+func PostDbaasInstance(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateDbaasInstanceRequestDto, DbaasInstanceDto, DbaasInstanceResponseDto](opts, DbaasInstance,
+		func(req FlattenedCreateDbaasInstanceRequestDto) DbaasInstanceDto {
+			var props *DbaasInstancePropertiesDto
+			if p := req.Properties; p != nil {
+				props = &DbaasInstancePropertiesDto{
+					Engine:        p.Engine,
+					FlavorName:    p.FlavorName,
+					Storage:       p.Storage,
+					Zone:          p.Zone,
+					Vpc:           p.Vpc,
+					Subnet:        p.Subnet,
+					SecurityGroup: p.SecurityGroup,
+					ElasticIp:     p.ElasticIp,
+				}
+				if p.AdminUser != nil {
+					props.AdminUser = &AdminUserDto{Username: p.AdminUser.Username, Password: p.AdminUser.password}
+				}
+			}
+			return DbaasInstanceDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: props,
+			}
+		})
+}
+
+// PutDbaasInstance
+// @Summary Update a DBaaS instance on Aruba Cloud
+// @Description Update a DBaaS instance on Aruba Cloud using the provided project and DBaaS instance details.
+// @Description Only the flavor and the storage size of a DBaaS instance can be changed.
+// @ID put-dbaas-instance
+// @Param projectId path string true "Project ID"
+// @Param id path string true "DBaaS Instance ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param dbaasInstanceUpdate body FlattenedUpdateDbaasInstanceRequestDto true "DBaaS instance update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedDbaasInstanceResponseDto "DBaaS instance details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Database/dbaas/{id} [put]
+func PutDbaasInstance(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateDbaasInstanceRequestDto, DbaasInstanceUpdateDto, DbaasInstanceResponseDto](opts, DbaasInstance,
+		func(req FlattenedUpdateDbaasInstanceRequestDto) DbaasInstanceUpdateDto {
+			return DbaasInstanceUpdateDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListDbaasInstances
+// @Summary List DBaaS instances on Aruba Cloud
+// @Description List DBaaS instances on Aruba Cloud using the provided project details.
+// @ID list-dbaas-instances
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedDbaasInstanceListResponseDto "A list of DBaaS instances"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Database/dbaas [get]
+func ListDbaasInstances(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[DbaasInstanceResponseDto, FlattenedDbaasInstanceResponseDto](opts, DbaasInstance)
+}
+
+// DeleteDbaasInstance
+// @Summary Delete a DBaaS instance on Aruba Cloud
+// @Description Delete a DBaaS instance on Aruba Cloud using the provided project and DBaaS instance details.
+// @Description Deleting a DBaaS instance that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-dbaas-instance
+// @Param projectId path string true "Project ID"
+// @Param id path string true "DBaaS Instance ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Database/dbaas/{id} [delete]
+func DeleteDbaasInstance(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, DbaasInstance)
+}
+
+// Database engines accepted by Aruba Cloud
+const (
+	EngineMySQL      = "MySQL"
+	EnginePostgreSQL = "PostgreSQL"
+)
+
+// Validate checks the engine, the network and the administrator of the DBaaS instance before it is sent to Aruba Cloud
+func (req FlattenedCreateDbaasInstanceRequestDto) Validate() error {
+	props := req.Properties
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if props.Engine == nil || props.Engine.Version == "" {
+		return errors.New("properties.engine.type and properties.engine.version are required")
+	}
+	if err := validation.OneOf("properties.engine.type", props.Engine.Type, EngineMySQL, EnginePostgreSQL); err != nil {
+		return err
+	}
+	if props.FlavorName == "" {
+		return errors.New("properties.flavorName is required")
+	}
+	if props.Storage == nil || props.Storage.SizeGb <= 0 {
+		return errors.New("properties.storage.sizeGb must be greater than 0")
+	}
+	if props.Vpc == nil || props.Vpc.URI == "" {
+		return errors.New("properties.vpc.uri is required")
+	}
+	if props.Subnet == nil || props.Subnet.URI == "" {
+		return errors.New("properties.subnet.uri is required")
+	}
+	if props.AdminUser == nil || props.AdminUser.Username == "" {
+		return errors.New("properties.adminUser.username is required")
+	}
+	return props.AdminUser.PasswordSecretRef.Validate("properties.adminUser.passwordSecretRef")
+}
+
+// ResolveSecrets reads the password of the administrator from its Secret
+func (req *FlattenedCreateDbaasInstanceRequestDto) ResolveSecrets(ctx context.Context, resolver secrets.Resolver) error {
+	admin := req.Properties.AdminUser
+	password, err := resolvePassword(ctx, resolver, *admin.PasswordSecretRef)
+	if err != nil {
+		return err
+	}
+	admin.password = password
+	return nil
+}
+
+// Validate checks the storage of the DBaaS instance before it is sent to Aruba Cloud
+func (req FlattenedUpdateDbaasInstanceRequestDto) Validate() error {
+	if req.Properties != nil && req.Properties.Storage != nil && req.Properties.Storage.SizeGb < 0 {
+		return fmt.Errorf("properties.storage.sizeGb must not be negative, got %d", req.Properties.Storage.SizeGb)
+	}
+	return nil
+}
+
+// resolvePassword returns the password stored in the referenced Secret, which must not be empty
+func resolvePassword(ctx context.Context, resolver secrets.Resolver, ref secrets.Ref) (string, error) {
+	password, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	if password == "" {
+		return "", fmt.Errorf("%s is empty: %w", ref, secrets.ErrNotFound)
+	}
+	return password, nil
+}
@@ -0,0 +1,126 @@
+package dbaas
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/secrets"
+)
+
+// testPassword is the password stored in the Secrets of the tests, which must only be sent to Aruba Cloud
+const testPassword = "s3cret-Passw0rd"
+
+// newTestMux serves the DBaaS handlers reading Secrets, backed by an Aruba Cloud API answering with respond.
+// The returned buffer holds the logs of the handlers.
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call, *bytes.Buffer) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	logs := handlertest.CaptureLogs(&opts)
+	opts.Secrets = testResolver{"default/db key password": testPassword, "default/empty key password": ""}
+	mux := http.NewServeMux()
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Database/dbaas", PostDbaasInstance(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users", PostDatabaseUser(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users/{id}", PutDatabaseUser(opts))
+	return mux, calls, logs
+}
+
+// testResolver serves the Secret values by reference
+type testResolver map[string]string
+
+func (r testResolver) Resolve(ctx context.Context, ref secrets.Ref) (string, error) {
+	value, ok := r[ref.String()]
+	if !ok {
+		return "", fmt.Errorf("%s %w", ref, secrets.ErrNotFound)
+	}
+	return value, nil
+}
+
+// secretTestCase is a request reading a password from a Secret, expected to reach Aruba Cloud with expectedCall,
+// or to be rejected without calling Aruba Cloud when expectedCall is nil
+type secretTestCase struct {
+	name           string
+	method         string
+	target         string
+	body           string
+	expectedCall   *handlertest.Call
+	expectedStatus int
+}
+
+// runSecretTestCases serves the test cases against an Aruba Cloud API echoing the password in its responses,
+// checking that the password is never part of the responses nor of the logs
+func runSecretTestCases(t *testing.T, testCases []secretTestCase) {
+	t.Helper()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls, logs := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					w.WriteHeader(http.StatusCreated)
+				}
+				w.Write([]byte(`{"metadata":{"id":"x1","name":"x"},"properties":{"password":"` + testPassword + `","adminUser":{"username":"admin","password":"` + testPassword + `"}}}`))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			switch {
+			case tc.expectedCall == nil && len(*calls) != 0:
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			case tc.expectedCall != nil && (len(*calls) != 1 || (*calls)[0] != *tc.expectedCall):
+				t.Errorf("expected the upstream call %+v, got %+v", *tc.expectedCall, *calls)
+			}
+			if strings.Contains(rec.Body.String(), testPassword) {
+				t.Errorf("expected the password not to be part of the response, got '%s'", rec.Body.String())
+			}
+			if logs.Len() == 0 {
+				t.Error("expected the handlers to log the request")
+			}
+			if strings.Contains(logs.String(), testPassword) {
+				t.Errorf("expected the password not to be logged, got '%s'", logs.String())
+			}
+		})
+	}
+}
+
+// TestDbaasInstanceHandlers_Secrets tests that the password of the administrator is read from its Secret and only sent to Aruba Cloud
+func TestDbaasInstanceHandlers_Secrets(t *testing.T) {
+	const instancesURI = "/projects/p1/providers/Aruba.Database/dbaas?api-version=1.0"
+	instance := func(ref string) string {
+		return `{"name":"db","properties":{"engine":{"type":"MySQL","version":"8.0"},"flavorName":"DBO2A4","storage":{"sizeGb":20},` +
+			`"vpc":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1"},"subnet":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/subnets/s1"},` +
+			`"adminUser":{"username":"admin","passwordSecretRef":` + ref + `}}}`
+	}
+
+	runSecretTestCases(t, []secretTestCase{
+		{
+			name:   "password resolved",
+			method: http.MethodPost,
+			target: instancesURI,
+			body:   instance(`{"name":"db","namespace":"default","key":"password"}`),
+			expectedCall: &handlertest.Call{Method: http.MethodPost, URI: instancesURI, Body: `{"metadata":{"name":"db"},"properties":{"engine":{"type":"MySQL","version":"8.0"},"flavorName":"DBO2A4","storage":{"sizeGb":20},` +
+				`"vpc":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1"},"subnet":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/subnets/s1"},` +
+				`"adminUser":{"username":"admin","password":"` + testPassword + `"}}}`},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "missing Secret",
+			method:         http.MethodPost,
+			target:         instancesURI,
+			body:           instance(`{"name":"missing","namespace":"default","key":"password"}`),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "empty Secret",
+			method:         http.MethodPost,
+			target:         instancesURI,
+			body:           instance(`{"name":"empty","namespace":"default","key":"password"}`),
+			expectedStatus: http.StatusBadRequest,
+		},
+	})
+}
@@ -0,0 +1,544 @@
+package dbaas
+
+import "github.com/krateoplatformops/arubacloud-provider-kog/pkg/secrets"
+
+type DatabaseDto struct {
+	Metadata   *MetadataDto           `json:"metadata,omitempty"`
+	Properties *DatabasePropertiesDto `json:"properties,omitempty"`
+}
+
+type MetadataDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+}
+
+type LocationDto struct {
+	// Value is the region where the resource will be located.
+	// Available regions at present: ITBG-Bergamo.
+	Value string `json:"value,omitempty"`
+}
+
+type DbaasInstanceDto struct {
+	Metadata   *MetadataDto                `json:"metadata,omitempty"`
+	Properties *DbaasInstancePropertiesDto `json:"properties,omitempty"`
+}
+
+type DbaasInstanceUpdateDto struct {
+	Metadata   *MetadataDto                      `json:"metadata,omitempty"`
+	Properties *DbaasInstanceUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+type DatabaseUserDto struct {
+	Metadata   *MetadataDto               `json:"metadata,omitempty"`
+	Properties *DatabaseUserPropertiesDto `json:"properties,omitempty"`
+}
+
+type DbaasInstancePropertiesDto struct {
+	// Engine is the database engine of the instance.
+	Engine *EngineDto `json:"engine,omitempty"`
+	// FlavorName is the name of the flavor (CPU and RAM) of the instance, e.g. DBO2A4.
+	FlavorName string `json:"flavorName,omitempty"`
+	// Storage is the storage of the instance.
+	Storage *StorageDto `json:"storage,omitempty"`
+	// Zone is the availability zone of the instance, e.g. ITBG-1.
+	Zone string `json:"zone,omitempty"`
+	// Vpc is the VPC in which the instance is created.
+	Vpc *ReferenceDto `json:"vpc,omitempty"`
+	// Subnet is the subnet of the VPC the instance is attached to.
+	Subnet *ReferenceDto `json:"subnet,omitempty"`
+	// SecurityGroup is the security group applied to the instance.
+	SecurityGroup *ReferenceDto `json:"securityGroup,omitempty"`
+	// ElasticIp is the Elastic IP exposing the instance, if any.
+	ElasticIp *ReferenceDto `json:"elasticIp,omitempty"`
+	// AdminUser is the administrator of the instance.
+	AdminUser *AdminUserDto `json:"adminUser,omitempty"`
+}
+
+type AdminUserDto struct {
+	// Username of the administrator.
+	Username string `json:"username,omitempty"`
+	// Password of the administrator.
+	Password string `json:"password,omitempty"`
+}
+
+// DbaasInstanceRequestPropertiesDto are the properties of the flattened request,
+// where the password of the administrator is referenced by a Kubernetes Secret
+type DbaasInstanceRequestPropertiesDto struct {
+	// Engine is the database engine of the instance.
+	Engine *EngineDto `json:"engine,omitempty"`
+	// FlavorName is the name of the flavor (CPU and RAM) of the instance, e.g. DBO2A4.
+	FlavorName string `json:"flavorName,omitempty"`
+	// Storage is the storage of the instance.
+	Storage *StorageDto `json:"storage,omitempty"`
+	// Zone is the availability zone of the instance, e.g. ITBG-1.
+	Zone string `json:"zone,omitempty"`
+	// Vpc is the VPC in which the instance is created.
+	Vpc *ReferenceDto `json:"vpc,omitempty"`
+	// Subnet is the subnet of the VPC the instance is attached to.
+	Subnet *ReferenceDto `json:"subnet,omitempty"`
+	// SecurityGroup is the security group applied to the instance.
+	SecurityGroup *ReferenceDto `json:"securityGroup,omitempty"`
+	// ElasticIp is the Elastic IP exposing the instance, if any.
+	ElasticIp *ReferenceDto `json:"elasticIp,omitempty"`
+	// AdminUser is the administrator of the instance.
+	AdminUser *AdminUserRequestDto `json:"adminUser,omitempty"`
+}
+
+type AdminUserRequestDto struct {
+	// Username of the administrator.
+	Username string `json:"username,omitempty"`
+	// PasswordSecretRef is the key of the Kubernetes Secret holding the password of the administrator.
+	PasswordSecretRef *secrets.Ref `json:"passwordSecretRef,omitempty"`
+
+	password string
+}
+
+type DbaasInstanceUpdatePropertiesDto struct {
+	// FlavorName is the name of the flavor (CPU and RAM) of the instance.
+	FlavorName string `json:"flavorName,omitempty"`
+	// Storage is the storage of the instance. It can only be extended.
+	Storage *StorageDto `json:"storage,omitempty"`
+}
+
+type EngineDto struct {
+	// Type is the database engine.
+	// Allowed values: MySQL, PostgreSQL.
+	Type string `json:"type,omitempty"`
+	// Version is the version of the engine, e.g. 8.0 for MySQL or 16 for PostgreSQL.
+	Version string `json:"version,omitempty"`
+}
+
+type StorageDto struct {
+	// SizeGb is the size of the storage in GB.
+	SizeGb int32 `json:"sizeGb,omitempty"`
+}
+
+type DatabasePropertiesDto struct {
+	// Charset is the character set of the database, e.g. utf8mb4. The default of the engine is used if empty.
+	Charset string `json:"charset,omitempty"`
+	// Collation is the collation of the database, e.g. utf8mb4_general_ci.
+	Collation string `json:"collation,omitempty"`
+}
+
+type DatabaseUserPropertiesDto struct {
+	// Password of the user.
+	Password string `json:"password,omitempty"`
+	// Grants are the roles of the user on the databases of the instance.
+	Grants []GrantDto `json:"grants,omitempty"`
+}
+
+// DatabaseUserRequestPropertiesDto are the properties of the flattened request,
+// where the password of the user is referenced by a Kubernetes Secret
+type DatabaseUserRequestPropertiesDto struct {
+	// PasswordSecretRef is the key of the Kubernetes Secret holding the password of the user.
+	PasswordSecretRef *secrets.Ref `json:"passwordSecretRef,omitempty"`
+	// Grants are the roles of the user on the databases of the instance.
+	Grants []GrantDto `json:"grants,omitempty"`
+
+	password string
+}
+
+type GrantDto struct {
+	// Database is the name of the database.
+	Database string `json:"database,omitempty"`
+	// Role is the role of the user on the database.
+	// Allowed values: ReadOnly, ReadWrite, Owner.
+	Role string `json:"role,omitempty"`
+}
+
+type ReferenceDto struct {
+	// URI is the URI of the referenced resource,
+	// e.g. /projects/<PROJECT_ID>/providers/Aruba.Network/vpcs/<VPC_ID>/subnets/<SUBNET_ID>.
+	URI string `json:"uri,omitempty"`
+}
+
+type DbaasInstanceResponseDto struct {
+	Metadata   *MetadataResponseDto                `json:"metadata,omitempty"`
+	Status     *StatusResponseDto                  `json:"status,omitempty"`
+	Properties *DbaasInstancePropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type DatabaseResponseDto struct {
+	Metadata   *MetadataResponseDto           `json:"metadata,omitempty"`
+	Status     *StatusResponseDto             `json:"status,omitempty"`
+	Properties *DatabasePropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type DatabaseUserResponseDto struct {
+	Metadata   *MetadataResponseDto               `json:"metadata,omitempty"`
+	Status     *StatusResponseDto                 `json:"status,omitempty"`
+	Properties *DatabaseUserPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type MetadataResponseDto struct {
+	ID           string               `json:"id,omitempty"`
+	URI          string               `json:"uri,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	Location     *LocationResponseDto `json:"location,omitempty"`
+	Project      *ProjectResponseDto  `json:"project,omitempty"`
+	Tags         []string             `json:"tags,omitempty"`
+	Category     *CategoryResponseDto `json:"category,omitempty"`
+	CreationDate string               `json:"creationDate,omitempty"`
+	CreatedBy    string               `json:"createdBy,omitempty"`
+	UpdateDate   string               `json:"updateDate,omitempty"`
+	UpdatedBy    string               `json:"updatedBy,omitempty"`
+	Version      string               `json:"version,omitempty"`
+	CreatedUser  string               `json:"createdUser,omitempty"`
+	UpdatedUser  string               `json:"updatedUser,omitempty"`
+}
+
+type LocationResponseDto struct {
+	// Code is the code of the region.
+	Code string `json:"code,omitempty"`
+	// Country is the country of the region.
+	Country string `json:"country,omitempty"`
+	// City is the city of the region.
+	City string `json:"city,omitempty"`
+	// Name is the name of the region.
+	Name string `json:"name,omitempty"`
+	// Value is the value of the region.
+	Value string `json:"value,omitempty"`
+}
+
+type ProjectResponseDto struct {
+	// ID is the unique identifier of the project.
+	ID string `json:"id,omitempty"`
+}
+
+type CategoryResponseDto struct {
+	// Name is the name of the category.
+	Name string `json:"name,omitempty"`
+	// Provider is the provider of the category.
+	Provider string `json:"provider,omitempty"`
+	// Typology is the typology of the category.
+	Typology *TypologyResponseDto `json:"typology,omitempty"`
+}
+
+type TypologyResponseDto struct {
+	// ID is the unique identifier of the typology.
+	ID string `json:"id,omitempty"`
+	// Name is the name of the typology.
+	Name string `json:"name,omitempty"`
+}
+
+type StatusResponseDto struct {
+	// State is the state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the status.
+	CreationDate string `json:"creationDate,omitempty"`
+	// DisableStatusInfo contains the information about the disabled status of the resource.
+	DisableStatusInfo *DisableStatusInfoResponseDto `json:"disableStatusInfo,omitempty"`
+	// FailureReason is the reason of the failure, if any.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+type DisableStatusInfoResponseDto struct {
+	// IsDisabled indicates if the resource is disabled.
+	IsDisabled bool `json:"isDisabled,omitempty"`
+	// Reasons is a list of reasons for the disabled status.
+	Reasons []string `json:"reasons,omitempty"`
+	// PreviousStatus is the previous status of the resource.
+	PreviousStatus *PreviousStatusResponseDto `json:"previousStatus,omitempty"`
+}
+
+type PreviousStatusResponseDto struct {
+	// State is the previous state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the previous status.
+	CreationDate string `json:"creationDate,omitempty"`
+}
+
+type DbaasInstancePropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the instance, e.g. its VPC and subnet.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Engine is the database engine of the instance.
+	Engine *EngineDto `json:"engine,omitempty"`
+	// FlavorName is the name of the flavor of the instance.
+	FlavorName string `json:"flavorName,omitempty"`
+	// Storage is the storage of the instance.
+	Storage *StorageDto `json:"storage,omitempty"`
+	// Zone is the availability zone of the instance.
+	Zone string `json:"zone,omitempty"`
+	// Vpc is the VPC of the instance.
+	Vpc *ReferenceDto `json:"vpc,omitempty"`
+	// Subnet is the subnet the instance is attached to.
+	Subnet *ReferenceDto `json:"subnet,omitempty"`
+	// SecurityGroup is the security group applied to the instance.
+	SecurityGroup *ReferenceDto `json:"securityGroup,omitempty"`
+	// ElasticIp is the Elastic IP exposing the instance, if any.
+	ElasticIp *ReferenceDto `json:"elasticIp,omitempty"`
+	// AdminUser is the administrator of the instance, without its password.
+	AdminUser *AdminUserResponseDto `json:"adminUser,omitempty"`
+	// Endpoint is the address the clients connect to.
+	Endpoint *EndpointDto `json:"endpoint,omitempty"`
+}
+
+type AdminUserResponseDto struct {
+	// Username of the administrator.
+	Username string `json:"username,omitempty"`
+}
+
+type EndpointDto struct {
+	// Host is the host name or IP address of the instance.
+	Host string `json:"host,omitempty"`
+	// Port is the port of the instance, e.g. 3306 for MySQL or 5432 for PostgreSQL.
+	Port int32 `json:"port,omitempty"`
+}
+
+type DatabasePropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the database, e.g. its users.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Charset is the character set of the database.
+	Charset string `json:"charset,omitempty"`
+	// Collation is the collation of the database.
+	Collation string `json:"collation,omitempty"`
+}
+
+type DatabaseUserPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the user, e.g. its databases.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Grants are the roles of the user on the databases of the instance.
+	Grants []GrantDto `json:"grants,omitempty"`
+}
+
+type LinkedResourceResponseDto struct {
+	// URI is the URI of the linked resource.
+	URI string `json:"uri,omitempty"`
+	// StrictCorrelation indicates if the correlation is strict.
+	StrictCorrelation bool `json:"strictCorrelation,omitempty"`
+}
+
+// --------------------------------------------------------------------------
+// Flattened types
+// --------------------------------------------------------------------------
+
+// FlattenedCreateDbaasInstanceRequestDto is the flattened request body for creating a DBaaS instance.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateDbaasInstanceRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the DBaaS instance.
+	Properties *DbaasInstanceRequestPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateDbaasInstanceRequestDto is the flattened request body for updating a DBaaS instance.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateDbaasInstanceRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the DBaaS instance.
+	Properties *DbaasInstanceUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedDbaasInstanceResponseDto is the flattened response body for a single DBaaS instance.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedDbaasInstanceResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the DBaaS instance.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the DBaaS instance.
+	Properties *DbaasInstancePropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedDbaasInstanceListResponseDto struct {
+	// Total is the total number of DBaaS instances.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened DBaaS instances.
+	Values []FlattenedDbaasInstanceResponseDto `json:"values,omitempty"`
+}
+
+// FlattenedCreateDatabaseRequestDto is the flattened request body for creating a database.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateDatabaseRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the database.
+	Properties *DatabasePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedDatabaseResponseDto is the flattened response body for a single database.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedDatabaseResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the database.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the database.
+	Properties *DatabasePropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedDatabaseListResponseDto struct {
+	// Total is the total number of databases.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened databases.
+	Values []FlattenedDatabaseResponseDto `json:"values,omitempty"`
+}
+
+// FlattenedCreateDatabaseUserRequestDto is the flattened request body for creating a database user.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateDatabaseUserRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the database user.
+	Properties *DatabaseUserRequestPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateDatabaseUserRequestDto is the flattened request body for updating a database user.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateDatabaseUserRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the database user.
+	Properties *DatabaseUserRequestPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedDatabaseUserResponseDto is the flattened response body for a single database user.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedDatabaseUserResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the database user.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the database user.
+	Properties *DatabaseUserPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedDatabaseUserListResponseDto struct {
+	// Total is the total number of database users.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened database users.
+	Values []FlattenedDatabaseUserResponseDto `json:"values,omitempty"`
+}
@@ -0,0 +1,233 @@
+package dbaas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/secrets"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// DatabaseUser is the Aruba Cloud database user resource of a DBaaS instance
+var DatabaseUser = handlers.Resource{
+	Name:   "database user",
+	Plural: "database users",
+	Path:   "/projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users",
+	PathParams: []handlers.Param{
+		handlers.ProjectIDParam,
+		{Name: "dbaasId", Label: "DBaaS Instance ID"},
+	},
+	IDParam:       handlers.Param{Name: "id", Label: "Database User ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetDatabaseUser
+// @Summary Get a database user from Aruba Cloud
+// @Description Get a database user from Aruba Cloud using the provided project, DBaaS instance and database user details.
+// @ID get-database-user
+// @Param projectId path string true "Project ID"
+// @Param dbaasId path string true "DBaaS Instance ID"
+// @Param id path string true "Database User ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedDatabaseUserResponseDto "Database user details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users/{id} [get]
+func GetDatabaseUser(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[DatabaseUserResponseDto](opts, DatabaseUser)
+}
+
+// PostDatabaseUser
+// @Summary Create a new database user on Aruba Cloud
+// @Description Create a new database user on Aruba Cloud using the provided project and DBaaS instance details.
+// @Description The password of the user is read from the Kubernetes Secret referenced by properties.passwordSecretRef.
+// @ID post-database-user
+// @Param projectId path string true "Project ID"
+// @Param dbaasId path string true "DBaaS Instance ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param databaseUserCreate body FlattenedCreateDatabaseUserRequestDto true "Database user creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedDatabaseUserResponseDto "Database user details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users [post]
+func PostDatabaseUser(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateDatabaseUserRequestDto, DatabaseUserDto, DatabaseUserResponseDto](opts, DatabaseUser,
+		func(req FlattenedCreateDatabaseUserRequestDto) DatabaseUserDto {
+			return unflattenDatabaseUser(req.Name, req.Location, req.Tags, req.Properties)
+		})
+}
+
+// PutDatabaseUser
+// @Summary Update a database user on Aruba Cloud
+// @Description Update a database user on Aruba Cloud using the provided project, DBaaS instance and database user details.
+// @Description The password is changed when properties.passwordSecretRef is set, the grants replace the current ones.
+// @ID put-database-user
+// @Param projectId path string true "Project ID"
+// @Param dbaasId path string true "DBaaS Instance ID"
+// @Param id path string true "Database User ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param databaseUserUpdate body FlattenedUpdateDatabaseUserRequestDto true "Database user update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedDatabaseUserResponseDto "Database user details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users/{id} [put]
+func PutDatabaseUser(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateDatabaseUserRequestDto, DatabaseUserDto, DatabaseUserResponseDto](opts, DatabaseUser,
+		func(req FlattenedUpdateDatabaseUserRequestDto) DatabaseUserDto {
+			return unflattenDatabaseUser(req.Name, req.Location, req.Tags, req.Properties)
+		})
+}
+
+// ListDatabaseUsers
+// @Summary List database users on Aruba Cloud
+// @Description List database users on Aruba Cloud using the provided project and DBaaS instance details.
+// @ID list-database-users
+// @Param projectId path string true "Project ID"
+// @Param dbaasId path string true "DBaaS Instance ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedDatabaseUserListResponseDto "A list of database users"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users [get]
+func ListDatabaseUsers(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[DatabaseUserResponseDto, FlattenedDatabaseUserResponseDto](opts, DatabaseUser)
+}
+
+// DeleteDatabaseUser
+// @Summary Delete a database user on Aruba Cloud
+// @Description Delete a database user on Aruba Cloud using the provided project, DBaaS instance and database user details.
+// @Description Deleting a database user that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-database-user
+// @Param projectId path string true "Project ID"
+// @Param dbaasId path string true "DBaaS Instance ID"
+// @Param id path string true "Database User ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users/{id} [delete]
+func DeleteDatabaseUser(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, DatabaseUser)
+}
+
+// Roles granted to a database user on a database
+const (
+	RoleReadOnly  = "ReadOnly"
+	RoleReadWrite = "ReadWrite"
+	RoleOwner     = "Owner"
+)
+
+// Validate checks the password reference and the grants of the database user before it is sent to Aruba Cloud
+func (req FlattenedCreateDatabaseUserRequestDto) Validate() error {
+	if req.Properties == nil {
+		return errors.New("properties are required")
+	}
+	if err := req.Properties.PasswordSecretRef.Validate("properties.passwordSecretRef"); err != nil {
+		return err
+	}
+	return validateGrants(req.Properties.Grants)
+}
+
+// ResolveSecrets reads the password of the database user from its Secret
+func (req *FlattenedCreateDatabaseUserRequestDto) ResolveSecrets(ctx context.Context, resolver secrets.Resolver) error {
+	return req.Properties.readPassword(ctx, resolver)
+}
+
+// Validate checks the password reference, when the password is changed, and the grants of the database user
+func (req FlattenedUpdateDatabaseUserRequestDto) Validate() error {
+	if req.Properties == nil {
+		return nil
+	}
+	if req.Properties.PasswordSecretRef != nil {
+		if err := req.Properties.PasswordSecretRef.Validate("properties.passwordSecretRef"); err != nil {
+			return err
+		}
+	}
+	return validateGrants(req.Properties.Grants)
+}
+
+// ResolveSecrets reads the new password of the database user from its Secret, if referenced
+func (req *FlattenedUpdateDatabaseUserRequestDto) ResolveSecrets(ctx context.Context, resolver secrets.Resolver) error {
+	if req.Properties == nil || req.Properties.PasswordSecretRef == nil {
+		return nil
+	}
+	return req.Properties.readPassword(ctx, resolver)
+}
+
+// readPassword reads the password referenced by PasswordSecretRef
+func (props *DatabaseUserRequestPropertiesDto) readPassword(ctx context.Context, resolver secrets.Resolver) error {
+	password, err := resolvePassword(ctx, resolver, *props.PasswordSecretRef)
+	if err != nil {
+		return err
+	}
+	props.password = password
+	return nil
+}
+
+// unflattenDatabaseUser builds the Aruba Cloud request, with the password read from the Secret
+func unflattenDatabaseUser(name string, location *LocationDto, tags []string, props *DatabaseUserRequestPropertiesDto) DatabaseUserDto {
+	dto := DatabaseUserDto{
+		Metadata: &MetadataDto{
+			Name:     name,
+			Location: location,
+			Tags:     tags,
+		},
+	}
+	if props != nil {
+		dto.Properties = &DatabaseUserPropertiesDto{
+			Password: props.password,
+			Grants:   props.Grants,
+		}
+	}
+	return dto
+}
+
+// validateGrants checks that every grant names a database and a known role
+func validateGrants(grants []GrantDto) error {
+	for i, grant := range grants {
+		if grant.Database == "" {
+			return fmt.Errorf("properties.grants[%d].database is required", i)
+		}
+		if err := validation.OneOf(fmt.Sprintf("properties.grants[%d].role", i), grant.Role, RoleReadOnly, RoleReadWrite, RoleOwner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
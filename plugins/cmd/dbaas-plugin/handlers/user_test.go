@@ -0,0 +1,56 @@
+package dbaas
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// TestDatabaseUserHandlers_Secrets tests that the password of the database users is read from its Secret and only sent to Aruba Cloud
+func TestDatabaseUserHandlers_Secrets(t *testing.T) {
+	const usersURI = "/projects/p1/providers/Aruba.Database/dbaas/db1/users?api-version=1.0"
+	const userURI = "/projects/p1/providers/Aruba.Database/dbaas/db1/users/u1?api-version=1.0"
+	const grants = `"grants":[{"database":"app","role":"ReadWrite"}]`
+
+	runSecretTestCases(t, []secretTestCase{
+		{
+			name:           "create with the password resolved",
+			method:         http.MethodPost,
+			target:         usersURI,
+			body:           `{"name":"app","properties":{"passwordSecretRef":{"name":"db","namespace":"default","key":"password"},` + grants + `}}`,
+			expectedCall:   &handlertest.Call{Method: http.MethodPost, URI: usersURI, Body: `{"metadata":{"name":"app"},"properties":{"password":"` + testPassword + `",` + grants + `}}`},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "update with the password resolved",
+			method:         http.MethodPut,
+			target:         userURI,
+			body:           `{"name":"app","properties":{"passwordSecretRef":{"name":"db","namespace":"default","key":"password"},` + grants + `}}`,
+			expectedCall:   &handlertest.Call{Method: http.MethodPut, URI: userURI, Body: `{"metadata":{"name":"app"},"properties":{"password":"` + testPassword + `",` + grants + `}}`},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "update keeping the password",
+			method:         http.MethodPut,
+			target:         userURI,
+			body:           `{"name":"app","properties":{` + grants + `}}`,
+			expectedCall:   &handlertest.Call{Method: http.MethodPut, URI: userURI, Body: `{"metadata":{"name":"app"},"properties":{` + grants + `}}`},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "create with a missing Secret",
+			method:         http.MethodPost,
+			target:         usersURI,
+			body:           `{"name":"app","properties":{"passwordSecretRef":{"name":"missing","namespace":"default","key":"password"}}}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "update with an empty Secret",
+			method:         http.MethodPut,
+			target:         userURI,
+			body:           `{"name":"app","properties":{"passwordSecretRef":{"name":"empty","namespace":"default","key":"password"}}}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	dbaas "github.com/krateoplatformops/arubacloud-provider-kog/dbaas-plugin/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/health"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/server"
+	httpSwagger "github.com/swaggo/http-swagger"
+)
+
+// @title           Aruba Cloud DBaaS Plugin API for Krateo Operator Generator (KOG)
+// @version         1.0
+// @description     Simple wrapper around Aruba Cloud API to provide consistency of API response for Krateo Operator Generator (KOG)
+// @termsOfService  http://swagger.io/terms/
+// @contact.name    Krateo Support
+// @contact.url     https://krateo.io
+// @contact.email   contact@krateoplatformops.io
+// @license.name    Apache 2.0
+// @license.url     http://www.apache.org/licenses/LICENSE-2.0.html
+// @host            localhost:8080
+// @BasePath        /
+// @schemes         http
+func main() {
+	srv := server.New()
+
+	opts := handlers.HandlerOptions{
+		Log:      srv.Logger(),
+		Client:   srv.HTTPClient(),
+		BaseURL:  srv.BaseURL(),
+		Auth:     srv.TokenProvider(),
+		Timeouts: srv.UpstreamTimeouts(),
+		Secrets:  srv.SecretResolver(),
+	}
+
+	// DBaaS instance
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Database/dbaas", dbaas.PostDbaasInstance(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Database/dbaas", dbaas.ListDbaasInstances(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Database/dbaas/{id}", dbaas.GetDbaasInstance(opts))
+	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Database/dbaas/{id}", dbaas.PutDbaasInstance(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Database/dbaas/{id}", dbaas.DeleteDbaasInstance(opts))
+
+	// Database
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/databases", dbaas.PostDatabase(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/databases", dbaas.ListDatabases(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/databases/{id}", dbaas.GetDatabase(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/databases/{id}", dbaas.DeleteDatabase(opts))
+
+	// Database user
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users", dbaas.PostDatabaseUser(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users", dbaas.ListDatabaseUsers(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users/{id}", dbaas.GetDatabaseUser(opts))
+	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users/{id}", dbaas.PutDatabaseUser(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Database/dbaas/{dbaasId}/users/{id}", dbaas.DeleteDatabaseUser(opts))
+
+	// Swagger UI
+	srv.Mux().Handle("/swagger/", httpSwagger.WrapHandler)
+
+	// Kubernetes health check endpoints
+	srv.Mux().HandleFunc("GET /healthz", health.LivenessHandler(srv.Healthy()))
+	srv.Mux().HandleFunc("GET /readyz", srv.ReadinessHandler())
+
+	srv.Run()
+}
@@ -0,0 +1,146 @@
+package elasticip
+
+import (
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+)
+
+// ElasticIp is the Aruba Cloud elastic IP resource
+var ElasticIp = handlers.Resource{
+	Name:          "elastic IP",
+	Plural:        "elastic IPs",
+	Path:          "/projects/{projectId}/providers/Aruba.Network/elasticIps",
+	PathParams:    []handlers.Param{handlers.ProjectIDParam},
+	IDParam:       handlers.Param{Name: "id", Label: "Elastic IP ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetElasticIp
+// @Summary Get an elastic IP from Aruba Cloud
+// @Description Get an elastic IP from Aruba Cloud using the provided project and elastic IP details.
+// @ID get-elastic-ip
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Elastic IP ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedElasticIpResponseDto "Elastic IP details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/elasticIps/{id} [get]
+func GetElasticIp(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[ElasticIpResponseDto](opts, ElasticIp)
+}
+
+// PostElasticIp
+// @Summary Create a new elastic IP on Aruba Cloud
+// @Description Create a new elastic IP on Aruba Cloud using the provided project details.
+// @ID post-elastic-ip
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param elasticIpCreate body FlattenedCreateElasticIpRequestDto true "Elastic IP creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedElasticIpResponseDto "Elastic IP details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/elasticIps [post]
+func PostElasticIp(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateElasticIpRequestDto, ElasticIpDto, ElasticIpResponseDto](opts, ElasticIp,
+		func(req FlattenedCreateElasticIpRequestDto) ElasticIpDto {
+			return ElasticIpDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutElasticIp
+// @Summary Update an elastic IP on Aruba Cloud
+// @Description Update an elastic IP on Aruba Cloud using the provided project and elastic IP details.
+// @Description Only the name, location and tags of an elastic IP can be updated.
+// @ID put-elastic-ip
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Elastic IP ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param elasticIpUpdate body FlattenedUpdateElasticIpRequestDto true "Elastic IP update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedElasticIpResponseDto "Elastic IP details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/elasticIps/{id} [put]
+func PutElasticIp(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateElasticIpRequestDto, ElasticIpUpdateDto, ElasticIpResponseDto](opts, ElasticIp,
+		func(req FlattenedUpdateElasticIpRequestDto) ElasticIpUpdateDto {
+			return ElasticIpUpdateDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+			}
+		})
+}
+
+// ListElasticIps
+// @Summary List elastic IPs on Aruba Cloud
+// @Description List elastic IPs on Aruba Cloud using the provided project details.
+// @ID list-elastic-ips
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedElasticIpListResponseDto "A list of elastic IPs"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/elasticIps [get]
+func ListElasticIps(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[ElasticIpResponseDto, FlattenedElasticIpResponseDto](opts, ElasticIp)
+}
+
+// DeleteElasticIp
+// @Summary Delete an elastic IP on Aruba Cloud
+// @Description Delete an elastic IP on Aruba Cloud using the provided project and elastic IP details.
+// @Description Deleting an elastic IP that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-elastic-ip
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Elastic IP ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/elasticIps/{id} [delete]
+func DeleteElasticIp(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, ElasticIp)
+}
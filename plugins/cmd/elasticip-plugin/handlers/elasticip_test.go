@@ -0,0 +1,114 @@
+package elasticip
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// newTestMux serves the elastic IP handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	mux := http.NewServeMux()
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Network/elasticIps", ListElasticIps(opts))
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Network/elasticIps/{id}", GetElasticIp(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Network/elasticIps", PostElasticIp(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Network/elasticIps/{id}", PutElasticIp(opts))
+	mux.Handle("DELETE /projects/{projectId}/providers/Aruba.Network/elasticIps/{id}", DeleteElasticIp(opts))
+	return mux, calls
+}
+
+// TestElasticIpHandlers tests the unflattening of the requests sent to Aruba Cloud and the flattening of the responses,
+// exposing the allocated address and the linked resources
+func TestElasticIpHandlers(t *testing.T) {
+	const eipsURI = "/projects/p1/providers/Aruba.Network/elasticIps"
+	eip := `{"metadata":{"id":"eip1","name":"web"},"status":{"state":"InUse"},"properties":{"address":"203.0.113.10","billingPlan":{"billingPeriod":"Hour"},"linkedResources":[{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/cs1"}]}}`
+	flattened := `{"id":"eip1","name":"web","properties":{"address":"203.0.113.10","billingPlan":{"billingPeriod":"Hour"},"linkedResources":[{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/cs1"}]},"status":{"state":"InUse"}}`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		upstreamStatus int
+		upstreamBody   string
+		expectedCall   handlertest.Call
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "get",
+			method:         http.MethodGet,
+			target:         eipsURI + "/eip1?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   eip,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: eipsURI + "/eip1?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "list",
+			method:         http.MethodGet,
+			target:         eipsURI + "?api-version=1.0&limit=10",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"total":1,"values":[` + eip + `]}`,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: eipsURI + "?api-version=1.0&limit=10"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"total":1,"values":[{"id":"eip1","name":"web","status":{"state":"InUse"},"properties":{"address":"203.0.113.10","billingPlan":{"billingPeriod":"Hour"},"linkedResources":[{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/cs1"}]}}]}`,
+		},
+		{
+			name:           "create",
+			method:         http.MethodPost,
+			target:         eipsURI + "?api-version=1.0",
+			body:           `{"name":"web","location":{"value":"ITBG-Bergamo"},"properties":{"billingPlan":{"billingPeriod":"Hour"}}}`,
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   eip,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: eipsURI + "?api-version=1.0", Body: `{"metadata":{"name":"web","location":{"value":"ITBG-Bergamo"}},"properties":{"billingPlan":{"billingPeriod":"Hour"}}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "update only carries the metadata",
+			method:         http.MethodPut,
+			target:         eipsURI + "/eip1?api-version=1.0",
+			body:           `{"name":"web","tags":["prod"],"properties":{"billingPlan":{"billingPeriod":"Month"}}}`,
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   eip,
+			expectedCall:   handlertest.Call{Method: http.MethodPut, URI: eipsURI + "/eip1?api-version=1.0", Body: `{"metadata":{"name":"web","tags":["prod"]}}`},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "delete",
+			method:         http.MethodDelete,
+			target:         eipsURI + "/eip1?api-version=1.0",
+			upstreamStatus: http.StatusAccepted,
+			expectedCall:   handlertest.Call{Method: http.MethodDelete, URI: eipsURI + "/eip1?api-version=1.0"},
+			expectedStatus: http.StatusAccepted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.upstreamStatus)
+				w.Write([]byte(tc.upstreamBody))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 1 || (*calls)[0] != tc.expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", tc.expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tc.expectedBody {
+				t.Errorf("expected body '%s', got '%s'", tc.expectedBody, got)
+			}
+		})
+	}
+}
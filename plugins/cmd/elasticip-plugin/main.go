@@ -0,0 +1,49 @@
+package main
+
+import (
+	elasticip "github.com/krateoplatformops/arubacloud-provider-kog/elasticip-plugin/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/health"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/server"
+	httpSwagger "github.com/swaggo/http-swagger"
+)
+
+// @title           Aruba Cloud Elastic IP Plugin API for Krateo Operator Generator (KOG)
+// @version         1.0
+// @description     Simple wrapper around Aruba Cloud API to provide consistency of API response for Krateo Operator Generator (KOG)
+// @termsOfService  http://swagger.io/terms/
+// @contact.name    Krateo Support
+// @contact.url     https://krateo.io
+// @contact.email   contact@krateoplatformops.io
+// @license.name    Apache 2.0
+// @license.url     http://www.apache.org/licenses/LICENSE-2.0.html
+// @host            localhost:8080
+// @BasePath        /
+// @schemes         http
+func main() {
+	srv := server.New()
+
+	opts := handlers.HandlerOptions{
+		Log:      srv.Logger(),
+		Client:   srv.HTTPClient(),
+		BaseURL:  srv.BaseURL(),
+		Auth:     srv.TokenProvider(),
+		Timeouts: srv.UpstreamTimeouts(),
+	}
+
+	// Elastic IP
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Network/elasticIps", elasticip.PostElasticIp(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Network/elasticIps", elasticip.ListElasticIps(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Network/elasticIps/{id}", elasticip.GetElasticIp(opts))
+	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Network/elasticIps/{id}", elasticip.PutElasticIp(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Network/elasticIps/{id}", elasticip.DeleteElasticIp(opts))
+
+	// Swagger UI
+	srv.Mux().Handle("/swagger/", httpSwagger.WrapHandler)
+
+	// Kubernetes health check endpoints
+	srv.Mux().HandleFunc("GET /healthz", health.LivenessHandler(srv.Healthy()))
+	srv.Mux().HandleFunc("GET /readyz", srv.ReadinessHandler())
+
+	srv.Run()
+}
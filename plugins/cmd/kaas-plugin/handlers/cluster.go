@@ -0,0 +1,198 @@
+package kaas
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// KaasCluster is the Aruba Cloud managed Kubernetes (KaaS) cluster resource
+var KaasCluster = handlers.Resource{
+	Name:          "KaaS cluster",
+	Plural:        "KaaS clusters",
+	Path:          "/projects/{projectId}/providers/Aruba.Container/kaas",
+	PathParams:    []handlers.Param{handlers.ProjectIDParam},
+	IDParam:       handlers.Param{Name: "id", Label: "KaaS Cluster ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetKaasCluster
+// @Summary Get a KaaS cluster from Aruba Cloud
+// @Description Get a KaaS cluster from Aruba Cloud using the provided project and KaaS cluster details.
+// @Description The state of the cluster is reported in status.state, e.g. InCreation while it is being provisioned and Active once it can be used.
+// @ID get-kaas-cluster
+// @Param projectId path string true "Project ID"
+// @Param id path string true "KaaS Cluster ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedKaasClusterResponseDto "KaaS cluster details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/kaas/{id} [get]
+func GetKaasCluster(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[KaasClusterResponseDto](opts, KaasCluster)
+}
+
+// PostKaasCluster
+// @Summary Create a new KaaS cluster on Aruba Cloud
+// @Description Create a new KaaS cluster on Aruba Cloud using the provided project details.
+// @ID post-kaas-cluster
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param kaasClusterCreate body FlattenedCreateKaasClusterRequestDto true "KaaS cluster creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedKaasClusterResponseDto "KaaS cluster details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/kaas [post]
+func PostKaasCluster(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateKaasClusterRequestDto, KaasClusterDto, KaasClusterResponseDto](opts, KaasCluster,
+		func(req FlattenedCreateKaasClusterRequestDto) KaasClusterDto {
+			return KaasClusterDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutKaasCluster
+// @Summary Update a KaaS cluster on Aruba Cloud
+// @Description Update a KaaS cluster on Aruba Cloud using the provided project and KaaS cluster details.
+// @Description Only the Kubernetes version of a KaaS cluster can be changed, to upgrade it. Its network and high availability are fixed at creation.
+// @ID put-kaas-cluster
+// @Param projectId path string true "Project ID"
+// @Param id path string true "KaaS Cluster ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param kaasClusterUpdate body FlattenedUpdateKaasClusterRequestDto true "KaaS cluster update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedKaasClusterResponseDto "KaaS cluster details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/kaas/{id} [put]
+func PutKaasCluster(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateKaasClusterRequestDto, KaasClusterUpdateDto, KaasClusterResponseDto](opts, KaasCluster,
+		func(req FlattenedUpdateKaasClusterRequestDto) KaasClusterUpdateDto {
+			return KaasClusterUpdateDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListKaasClusters
+// @Summary List KaaS clusters on Aruba Cloud
+// @Description List KaaS clusters on Aruba Cloud using the provided project details.
+// @ID list-kaas-clusters
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedKaasClusterListResponseDto "A list of KaaS clusters"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/kaas [get]
+func ListKaasClusters(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[KaasClusterResponseDto, FlattenedKaasClusterResponseDto](opts, KaasCluster)
+}
+
+// DeleteKaasCluster
+// @Summary Delete a KaaS cluster on Aruba Cloud
+// @Description Delete a KaaS cluster on Aruba Cloud using the provided project and KaaS cluster details.
+// @Description Deleting a KaaS cluster that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-kaas-cluster
+// @Param projectId path string true "Project ID"
+// @Param id path string true "KaaS Cluster ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/kaas/{id} [delete]
+func DeleteKaasCluster(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, KaasCluster)
+}
+
+// GetKaasClusterKubeconfig
+// @Summary Get the kubeconfig of a KaaS cluster from Aruba Cloud
+// @Description Get the kubeconfig of a KaaS cluster from Aruba Cloud using the provided project and KaaS cluster details.
+// @Description The kubeconfig carries the credentials of the cluster: it is only served by this endpoint and never logged.
+// @ID get-kaas-cluster-kubeconfig
+// @Param projectId path string true "Project ID"
+// @Param id path string true "KaaS Cluster ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} KubeconfigResponseDto "Kubeconfig of the KaaS cluster"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/kaas/{id}/kubeconfig [get]
+func GetKaasClusterKubeconfig(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Subresource[KubeconfigResponseDto](opts, KaasCluster, "kubeconfig", "kubeconfig")
+}
+
+// Validate checks the version and the network of the KaaS cluster before it is sent to Aruba Cloud
+func (req FlattenedCreateKaasClusterRequestDto) Validate() error {
+	props := req.Properties
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if props.KubernetesVersion == nil || props.KubernetesVersion.Value == "" {
+		return errors.New("properties.kubernetesVersion.value is required")
+	}
+	if props.Vpc == nil || props.Vpc.URI == "" {
+		return errors.New("properties.vpc.uri is required")
+	}
+	if props.Subnet == nil || props.Subnet.URI == "" {
+		return errors.New("properties.subnet.uri is required")
+	}
+	if props.NodeCidr == nil || props.NodeCidr.Address == "" {
+		return errors.New("properties.nodeCidr.address is required")
+	}
+	if _, err := validation.CIDR(props.NodeCidr.Address); err != nil {
+		return fmt.Errorf("properties.nodeCidr.address: %w", err)
+	}
+	return nil
+}
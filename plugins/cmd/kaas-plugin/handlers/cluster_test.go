@@ -0,0 +1,77 @@
+package kaas
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// newTestMux serves the KaaS update handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	mux := http.NewServeMux()
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Container/kaas/{id}", PutKaasCluster(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Container/kaas/{kaasId}/nodePools/{id}", PutKaasNodePool(opts))
+	return mux, calls
+}
+
+// TestKaasHandlers_Update tests that the updates only send the mutable fields to Aruba Cloud, when the whole spec of
+// the resource is received: the fields fixed at creation are left out of the body
+func TestKaasHandlers_Update(t *testing.T) {
+	const clusterURI = "/projects/p1/providers/Aruba.Container/kaas/k1?api-version=1.0"
+	const nodePoolURI = "/projects/p1/providers/Aruba.Container/kaas/k1/nodePools/np1?api-version=1.0"
+
+	testCases := []struct {
+		name            string
+		target          string
+		body            string
+		expectedBody    string
+		immutableFields []string
+	}{
+		{
+			name:   "cluster",
+			target: clusterURI,
+			body: `{"name":"prod","properties":{"kubernetesVersion":{"value":"1.30.2"},"vpc":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1"},` +
+				`"subnet":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/subnets/s1"},"nodeCidr":{"address":"10.100.0.0/16","name":"nodes"},"ha":true}}`,
+			expectedBody:    `{"metadata":{"name":"prod"},"properties":{"kubernetesVersion":{"value":"1.30.2"}}}`,
+			immutableFields: []string{`"vpc"`, `"subnet"`, `"nodeCidr"`, `"ha"`},
+		},
+		{
+			name:            "node pool",
+			target:          nodePoolURI,
+			body:            `{"name":"workers","properties":{"flavorName":"K4A8","zone":"ITBG-1","replicas":3,"autoscaling":{"enabled":true,"minReplicas":2,"maxReplicas":5}}}`,
+			expectedBody:    `{"metadata":{"name":"workers"},"properties":{"replicas":3,"autoscaling":{"enabled":true,"minReplicas":2,"maxReplicas":5}}}`,
+			immutableFields: []string{`"flavorName"`, `"zone"`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"metadata":{"id":"x1"}}`))
+			})
+
+			rec := handlertest.Serve(mux, http.MethodPut, tc.target, tc.body)
+
+			if rec.Code != http.StatusOK {
+				t.Errorf("expected status 200, got %d", rec.Code)
+			}
+			if len(*calls) != 1 {
+				t.Fatalf("expected one call to Aruba Cloud, got %+v", *calls)
+			}
+			call := (*calls)[0]
+			if call.Method != http.MethodPut || call.URI != tc.target || call.Body != tc.expectedBody {
+				t.Errorf("expected the upstream call PUT %s '%s', got %+v", tc.target, tc.expectedBody, call)
+			}
+			for _, field := range tc.immutableFields {
+				if strings.Contains(call.Body, field) {
+					t.Errorf("expected %s not to be sent to Aruba Cloud, got '%s'", field, call.Body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package kaas
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+)
+
+// KaasNodePool is the Aruba Cloud node pool resource of a KaaS cluster
+var KaasNodePool = handlers.Resource{
+	Name:   "KaaS node pool",
+	Plural: "KaaS node pools",
+	Path:   "/projects/{projectId}/providers/Aruba.Container/kaas/{kaasId}/nodePools",
+	PathParams: []handlers.Param{
+		handlers.ProjectIDParam,
+		{Name: "kaasId", Label: "KaaS Cluster ID"},
+	},
+	IDParam:       handlers.Param{Name: "id", Label: "Node Pool ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetKaasNodePool
+// @Summary Get a KaaS node pool from Aruba Cloud
+// @Description Get a KaaS node pool from Aruba Cloud using the provided project, KaaS cluster and KaaS node pool details.
+// @ID get-kaas-node-pool
+// @Param projectId path string true "Project ID"
+// @Param kaasId path string true "KaaS Cluster ID"
+// @Param id path string true "Node Pool ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedKaasNodePoolResponseDto "KaaS node pool details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/kaas/{kaasId}/nodePools/{id} [get]
+func GetKaasNodePool(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[KaasNodePoolResponseDto](opts, KaasNodePool)
+}
+
+// PostKaasNodePool
+// @Summary Create a new KaaS node pool on Aruba Cloud
+// @Description Create a new KaaS node pool on Aruba Cloud using the provided project and KaaS cluster details.
+// @ID post-kaas-node-pool
+// @Param projectId path string true "Project ID"
+// @Param kaasId path string true "KaaS Cluster ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param kaasNodePoolCreate body FlattenedCreateKaasNodePoolRequestDto true "KaaS node pool creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedKaasNodePoolResponseDto "KaaS node pool details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/kaas/{kaasId}/nodePools [post]
+func PostKaasNodePool(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateKaasNodePoolRequestDto, KaasNodePoolDto, KaasNodePoolResponseDto](opts, KaasNodePool,
+		func(req FlattenedCreateKaasNodePoolRequestDto) KaasNodePoolDto {
+			return KaasNodePoolDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutKaasNodePool
+// @Summary Update a KaaS node pool on Aruba Cloud
+// @Description Update a KaaS node pool on Aruba Cloud using the provided project, KaaS cluster and KaaS node pool details.
+// @Description Only the replica count and the autoscaling bounds of a node pool can be changed, its flavor and zone are fixed at creation.
+// @ID put-kaas-node-pool
+// @Param projectId path string true "Project ID"
+// @Param kaasId path string true "KaaS Cluster ID"
+// @Param id path string true "Node Pool ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param kaasNodePoolUpdate body FlattenedUpdateKaasNodePoolRequestDto true "KaaS node pool update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedKaasNodePoolResponseDto "KaaS node pool details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/kaas/{kaasId}/nodePools/{id} [put]
+func PutKaasNodePool(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateKaasNodePoolRequestDto, KaasNodePoolUpdateDto, KaasNodePoolResponseDto](opts, KaasNodePool,
+		func(req FlattenedUpdateKaasNodePoolRequestDto) KaasNodePoolUpdateDto {
+			return KaasNodePoolUpdateDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListKaasNodePools
+// @Summary List KaaS node pools on Aruba Cloud
+// @Description List KaaS node pools on Aruba Cloud using the provided project and KaaS cluster details.
+// @ID list-kaas-node-pools
+// @Param projectId path string true "Project ID"
+// @Param kaasId path string true "KaaS Cluster ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedKaasNodePoolListResponseDto "A list of KaaS node pools"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/kaas/{kaasId}/nodePools [get]
+func ListKaasNodePools(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[KaasNodePoolResponseDto, FlattenedKaasNodePoolResponseDto](opts, KaasNodePool)
+}
+
+// DeleteKaasNodePool
+// @Summary Delete a KaaS node pool on Aruba Cloud
+// @Description Delete a KaaS node pool on Aruba Cloud using the provided project, KaaS cluster and KaaS node pool details.
+// @Description Deleting a KaaS node pool that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-kaas-node-pool
+// @Param projectId path string true "Project ID"
+// @Param kaasId path string true "KaaS Cluster ID"
+// @Param id path string true "Node Pool ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Container/kaas/{kaasId}/nodePools/{id} [delete]
+func DeleteKaasNodePool(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, KaasNodePool)
+}
+
+// Validate checks the flavor and the scaling of the node pool before it is sent to Aruba Cloud
+func (req FlattenedCreateKaasNodePoolRequestDto) Validate() error {
+	props := req.Properties
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if props.FlavorName == "" {
+		return errors.New("properties.flavorName is required")
+	}
+	return validateScaling(props.Replicas, props.Autoscaling, true)
+}
+
+// Validate checks the scaling of the node pool before it is sent to Aruba Cloud
+func (req FlattenedUpdateKaasNodePoolRequestDto) Validate() error {
+	if req.Properties == nil {
+		return nil
+	}
+	return validateScaling(req.Properties.Replicas, req.Properties.Autoscaling, false)
+}
+
+// validateScaling checks that the replica count of a node pool lies within its autoscaling bounds.
+// Without autoscaling, a replica count is required on creation.
+func validateScaling(replicas int32, autoscaling *AutoscalingDto, required bool) error {
+	if replicas < 0 {
+		return fmt.Errorf("properties.replicas must not be negative, got %d", replicas)
+	}
+	if autoscaling == nil || !autoscaling.Enabled {
+		if required && replicas == 0 {
+			return errors.New("properties.replicas must be greater than 0 unless properties.autoscaling is enabled")
+		}
+		return nil
+	}
+	if autoscaling.MinReplicas < 1 {
+		return fmt.Errorf("properties.autoscaling.minReplicas must be at least 1, got %d", autoscaling.MinReplicas)
+	}
+	if autoscaling.MaxReplicas < autoscaling.MinReplicas {
+		return fmt.Errorf("properties.autoscaling.maxReplicas (%d) must not be lower than properties.autoscaling.minReplicas (%d)", autoscaling.MaxReplicas, autoscaling.MinReplicas)
+	}
+	if replicas != 0 && (replicas < autoscaling.MinReplicas || replicas > autoscaling.MaxReplicas) {
+		return fmt.Errorf("properties.replicas (%d) must be within the autoscaling bounds [%d, %d]", replicas, autoscaling.MinReplicas, autoscaling.MaxReplicas)
+	}
+	return nil
+}
@@ -0,0 +1,395 @@
+package kaas
+
+type KaasClusterDto struct {
+	Metadata   *MetadataDto              `json:"metadata,omitempty"`
+	Properties *KaasClusterPropertiesDto `json:"properties,omitempty"`
+}
+
+type KaasClusterUpdateDto struct {
+	Metadata   *MetadataDto                    `json:"metadata,omitempty"`
+	Properties *KaasClusterUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+type KaasNodePoolDto struct {
+	Metadata   *MetadataDto               `json:"metadata,omitempty"`
+	Properties *KaasNodePoolPropertiesDto `json:"properties,omitempty"`
+}
+
+type KaasNodePoolUpdateDto struct {
+	Metadata   *MetadataDto                     `json:"metadata,omitempty"`
+	Properties *KaasNodePoolUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+type MetadataDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+}
+
+type LocationDto struct {
+	// Value is the region where the resource will be located.
+	// Available regions at present: ITBG-Bergamo.
+	Value string `json:"value,omitempty"`
+}
+
+type KaasClusterPropertiesDto struct {
+	// KubernetesVersion is the Kubernetes version of the cluster.
+	KubernetesVersion *KubernetesVersionDto `json:"kubernetesVersion,omitempty"`
+	// Vpc is the VPC in which the cluster is created.
+	Vpc *ReferenceDto `json:"vpc,omitempty"`
+	// Subnet is the subnet of the VPC the nodes of the cluster are attached to.
+	Subnet *ReferenceDto `json:"subnet,omitempty"`
+	// NodeCidr is the network of the nodes of the cluster.
+	NodeCidr *NodeCidrDto `json:"nodeCidr,omitempty"`
+	// HA indicates if the control plane of the cluster is highly available.
+	HA bool `json:"ha,omitempty"`
+}
+
+type KaasClusterUpdatePropertiesDto struct {
+	// KubernetesVersion is the Kubernetes version the cluster is upgraded to.
+	KubernetesVersion *KubernetesVersionDto `json:"kubernetesVersion,omitempty"`
+}
+
+type KubernetesVersionDto struct {
+	// Value is the Kubernetes version, e.g. 1.30.2.
+	Value string `json:"value,omitempty"`
+}
+
+type NodeCidrDto struct {
+	// Address is the CIDR of the nodes of the cluster, e.g. 10.100.0.0/16.
+	Address string `json:"address,omitempty"`
+	// Name is the name of the node network.
+	Name string `json:"name,omitempty"`
+}
+
+type KaasNodePoolPropertiesDto struct {
+	// FlavorName is the name of the flavor (CPU and RAM) of the nodes, e.g. K4A8.
+	FlavorName string `json:"flavorName,omitempty"`
+	// Zone is the availability zone of the nodes, e.g. ITBG-1.
+	Zone string `json:"zone,omitempty"`
+	// Replicas is the number of nodes of the pool.
+	// With autoscaling, it is the initial number of nodes and must be within the autoscaling bounds.
+	Replicas int32 `json:"replicas,omitempty"`
+	// Autoscaling contains the bounds within which the number of nodes is scaled.
+	Autoscaling *AutoscalingDto `json:"autoscaling,omitempty"`
+}
+
+type KaasNodePoolUpdatePropertiesDto struct {
+	// Replicas is the number of nodes of the pool.
+	Replicas int32 `json:"replicas,omitempty"`
+	// Autoscaling contains the bounds within which the number of nodes is scaled.
+	Autoscaling *AutoscalingDto `json:"autoscaling,omitempty"`
+}
+
+type AutoscalingDto struct {
+	// Enabled indicates if the number of nodes is scaled automatically.
+	Enabled bool `json:"enabled,omitempty"`
+	// MinReplicas is the minimum number of nodes, at least 1.
+	MinReplicas int32 `json:"minReplicas,omitempty"`
+	// MaxReplicas is the maximum number of nodes, not lower than MinReplicas.
+	MaxReplicas int32 `json:"maxReplicas,omitempty"`
+}
+
+type ReferenceDto struct {
+	// URI is the URI of the referenced resource,
+	// e.g. /projects/<PROJECT_ID>/providers/Aruba.Network/vpcs/<VPC_ID>/subnets/<SUBNET_ID>.
+	URI string `json:"uri,omitempty"`
+}
+
+type KaasClusterResponseDto struct {
+	Metadata   *MetadataResponseDto              `json:"metadata,omitempty"`
+	Status     *StatusResponseDto                `json:"status,omitempty"`
+	Properties *KaasClusterPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type KaasNodePoolResponseDto struct {
+	Metadata   *MetadataResponseDto               `json:"metadata,omitempty"`
+	Status     *StatusResponseDto                 `json:"status,omitempty"`
+	Properties *KaasNodePoolPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type MetadataResponseDto struct {
+	ID           string               `json:"id,omitempty"`
+	URI          string               `json:"uri,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	Location     *LocationResponseDto `json:"location,omitempty"`
+	Project      *ProjectResponseDto  `json:"project,omitempty"`
+	Tags         []string             `json:"tags,omitempty"`
+	Category     *CategoryResponseDto `json:"category,omitempty"`
+	CreationDate string               `json:"creationDate,omitempty"`
+	CreatedBy    string               `json:"createdBy,omitempty"`
+	UpdateDate   string               `json:"updateDate,omitempty"`
+	UpdatedBy    string               `json:"updatedBy,omitempty"`
+	Version      string               `json:"version,omitempty"`
+	CreatedUser  string               `json:"createdUser,omitempty"`
+	UpdatedUser  string               `json:"updatedUser,omitempty"`
+}
+
+type LocationResponseDto struct {
+	// Code is the code of the region.
+	Code string `json:"code,omitempty"`
+	// Country is the country of the region.
+	Country string `json:"country,omitempty"`
+	// City is the city of the region.
+	City string `json:"city,omitempty"`
+	// Name is the name of the region.
+	Name string `json:"name,omitempty"`
+	// Value is the value of the region.
+	Value string `json:"value,omitempty"`
+}
+
+type ProjectResponseDto struct {
+	// ID is the unique identifier of the project.
+	ID string `json:"id,omitempty"`
+}
+
+type CategoryResponseDto struct {
+	// Name is the name of the category.
+	Name string `json:"name,omitempty"`
+	// Provider is the provider of the category.
+	Provider string `json:"provider,omitempty"`
+	// Typology is the typology of the category.
+	Typology *TypologyResponseDto `json:"typology,omitempty"`
+}
+
+type TypologyResponseDto struct {
+	// ID is the unique identifier of the typology.
+	ID string `json:"id,omitempty"`
+	// Name is the name of the typology.
+	Name string `json:"name,omitempty"`
+}
+
+type StatusResponseDto struct {
+	// State is the state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the status.
+	CreationDate string `json:"creationDate,omitempty"`
+	// DisableStatusInfo contains the information about the disabled status of the resource.
+	DisableStatusInfo *DisableStatusInfoResponseDto `json:"disableStatusInfo,omitempty"`
+	// FailureReason is the reason of the failure, if any.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+type DisableStatusInfoResponseDto struct {
+	// IsDisabled indicates if the resource is disabled.
+	IsDisabled bool `json:"isDisabled,omitempty"`
+	// Reasons is a list of reasons for the disabled status.
+	Reasons []string `json:"reasons,omitempty"`
+	// PreviousStatus is the previous status of the resource.
+	PreviousStatus *PreviousStatusResponseDto `json:"previousStatus,omitempty"`
+}
+
+type PreviousStatusResponseDto struct {
+	// State is the previous state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the previous status.
+	CreationDate string `json:"creationDate,omitempty"`
+}
+
+type KaasClusterPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the cluster, e.g. its VPC and subnet.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// KubernetesVersion is the Kubernetes version of the cluster.
+	KubernetesVersion *KubernetesVersionDto `json:"kubernetesVersion,omitempty"`
+	// Vpc is the VPC of the cluster.
+	Vpc *ReferenceDto `json:"vpc,omitempty"`
+	// Subnet is the subnet the nodes of the cluster are attached to.
+	Subnet *ReferenceDto `json:"subnet,omitempty"`
+	// NodeCidr is the network of the nodes of the cluster.
+	NodeCidr *NodeCidrDto `json:"nodeCidr,omitempty"`
+	// HA indicates if the control plane of the cluster is highly available.
+	HA bool `json:"ha,omitempty"`
+}
+
+type KaasNodePoolPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the node pool, e.g. its cluster.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// FlavorName is the name of the flavor of the nodes.
+	FlavorName string `json:"flavorName,omitempty"`
+	// Zone is the availability zone of the nodes.
+	Zone string `json:"zone,omitempty"`
+	// Replicas is the desired number of nodes of the pool.
+	Replicas int32 `json:"replicas,omitempty"`
+	// Autoscaling contains the bounds within which the number of nodes is scaled.
+	Autoscaling *AutoscalingDto `json:"autoscaling,omitempty"`
+}
+
+type LinkedResourceResponseDto struct {
+	// URI is the URI of the linked resource.
+	URI string `json:"uri,omitempty"`
+	// StrictCorrelation indicates if the correlation is strict.
+	StrictCorrelation bool `json:"strictCorrelation,omitempty"`
+}
+
+// --------------------------------------------------------------------------
+// Flattened types
+// --------------------------------------------------------------------------
+
+// FlattenedCreateKaasClusterRequestDto is the flattened request body for creating a KaaS cluster.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateKaasClusterRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the KaaS cluster.
+	Properties *KaasClusterPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateKaasClusterRequestDto is the flattened request body for updating a KaaS cluster.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateKaasClusterRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the KaaS cluster.
+	Properties *KaasClusterUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedKaasClusterResponseDto is the flattened response body for a single KaaS cluster.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedKaasClusterResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the KaaS cluster.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the KaaS cluster.
+	Properties *KaasClusterPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedKaasClusterListResponseDto struct {
+	// Total is the total number of KaaS clusters.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened KaaS clusters.
+	Values []FlattenedKaasClusterResponseDto `json:"values,omitempty"`
+}
+
+// FlattenedCreateKaasNodePoolRequestDto is the flattened request body for creating a KaaS node pool.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateKaasNodePoolRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the KaaS node pool.
+	Properties *KaasNodePoolPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateKaasNodePoolRequestDto is the flattened request body for updating a KaaS node pool.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateKaasNodePoolRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the KaaS node pool.
+	Properties *KaasNodePoolUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedKaasNodePoolResponseDto is the flattened response body for a single KaaS node pool.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedKaasNodePoolResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the KaaS node pool.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the KaaS node pool.
+	Properties *KaasNodePoolPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedKaasNodePoolListResponseDto struct {
+	// Total is the total number of KaaS node pools.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened KaaS node pools.
+	Values []FlattenedKaasNodePoolResponseDto `json:"values,omitempty"`
+}
+
+// KubeconfigResponseDto is the kubeconfig of a KaaS cluster, returned as is by the kubeconfig endpoint
+type KubeconfigResponseDto struct {
+	// Content is the kubeconfig file of the cluster, base64 encoded.
+	Content string `json:"content,omitempty"`
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	kaas "github.com/krateoplatformops/arubacloud-provider-kog/kaas-plugin/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/health"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/server"
+	httpSwagger "github.com/swaggo/http-swagger"
+)
+
+// @title           Aruba Cloud KaaS Plugin API for Krateo Operator Generator (KOG)
+// @version         1.0
+// @description     Simple wrapper around Aruba Cloud API to provide consistency of API response for Krateo Operator Generator (KOG)
+// @termsOfService  http://swagger.io/terms/
+// @contact.name    Krateo Support
+// @contact.url     https://krateo.io
+// @contact.email   contact@krateoplatformops.io
+// @license.name    Apache 2.0
+// @license.url     http://www.apache.org/licenses/LICENSE-2.0.html
+// @host            localhost:8080
+// @BasePath        /
+// @schemes         http
+func main() {
+	srv := server.New()
+
+	opts := handlers.HandlerOptions{
+		Log:      srv.Logger(),
+		Client:   srv.HTTPClient(),
+		BaseURL:  srv.BaseURL(),
+		Auth:     srv.TokenProvider(),
+		Timeouts: srv.UpstreamTimeouts(),
+	}
+
+	// KaaS cluster
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Container/kaas", kaas.PostKaasCluster(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Container/kaas", kaas.ListKaasClusters(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Container/kaas/{id}", kaas.GetKaasCluster(opts))
+	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Container/kaas/{id}", kaas.PutKaasCluster(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Container/kaas/{id}", kaas.DeleteKaasCluster(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Container/kaas/{id}/kubeconfig", kaas.GetKaasClusterKubeconfig(opts))
+
+	// KaaS node pool
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Container/kaas/{kaasId}/nodePools", kaas.PostKaasNodePool(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Container/kaas/{kaasId}/nodePools", kaas.ListKaasNodePools(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Container/kaas/{kaasId}/nodePools/{id}", kaas.GetKaasNodePool(opts))
+	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Container/kaas/{kaasId}/nodePools/{id}", kaas.PutKaasNodePool(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Container/kaas/{kaasId}/nodePools/{id}", kaas.DeleteKaasNodePool(opts))
+
+	// Swagger UI
+	srv.Mux().Handle("/swagger/", httpSwagger.WrapHandler)
+
+	// Kubernetes health check endpoints
+	srv.Mux().HandleFunc("GET /healthz", health.LivenessHandler(srv.Healthy()))
+	srv.Mux().HandleFunc("GET /readyz", srv.ReadinessHandler())
+
+	srv.Run()
+}
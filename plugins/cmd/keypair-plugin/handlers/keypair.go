@@ -0,0 +1,142 @@
+package keypair
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// KeyPair is the Aruba Cloud SSH key pair resource
+var KeyPair = handlers.Resource{
+	Name:          "key pair",
+	Plural:        "key pairs",
+	Path:          "/projects/{projectId}/providers/Aruba.Compute/keyPairs",
+	PathParams:    []handlers.Param{handlers.ProjectIDParam},
+	IDParam:       handlers.Param{Name: "id", Label: "Key Pair ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetKeyPair
+// @Summary Get a key pair from Aruba Cloud
+// @Description Get a key pair from Aruba Cloud using the provided project and key pair details.
+// @Description The SHA256 fingerprint of the public key is computed by the plugin.
+// @ID get-key-pair
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Key Pair ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedKeyPairResponseDto "Key pair details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Compute/keyPairs/{id} [get]
+func GetKeyPair(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[KeyPairResponseDto](opts, KeyPair)
+}
+
+// PostKeyPair
+// @Summary Create a new key pair on Aruba Cloud
+// @Description Create a new key pair on Aruba Cloud using the provided project details.
+// @Description The public key must be in the OpenSSH format (ssh-rsa, ssh-ed25519 or ecdsa-sha2-nistp256/384/521) and is validated before calling Aruba Cloud.
+// @Description Key pairs cannot be updated: a new key pair must be created to change the public key.
+// @ID post-key-pair
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param keyPairCreate body FlattenedCreateKeyPairRequestDto true "Key pair creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedKeyPairResponseDto "Key pair details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Compute/keyPairs [post]
+func PostKeyPair(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateKeyPairRequestDto, KeyPairDto, KeyPairResponseDto](opts, KeyPair,
+		func(req FlattenedCreateKeyPairRequestDto) KeyPairDto {
+			return KeyPairDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListKeyPairs
+// @Summary List key pairs on Aruba Cloud
+// @Description List key pairs on Aruba Cloud using the provided project details.
+// @ID list-key-pairs
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedKeyPairListResponseDto "A list of key pairs"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Compute/keyPairs [get]
+func ListKeyPairs(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[KeyPairResponseDto, FlattenedKeyPairResponseDto](opts, KeyPair)
+}
+
+// DeleteKeyPair
+// @Summary Delete a key pair on Aruba Cloud
+// @Description Delete a key pair on Aruba Cloud using the provided project and key pair details.
+// @Description Deleting a key pair that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-key-pair
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Key Pair ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Compute/keyPairs/{id} [delete]
+func DeleteKeyPair(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, KeyPair)
+}
+
+// Validate checks the format of the public key before it is sent to Aruba Cloud
+func (req FlattenedCreateKeyPairRequestDto) Validate() error {
+	if req.Properties == nil || req.Properties.Value == "" {
+		return errors.New("properties.value is required")
+	}
+	if _, err := validation.SSHPublicKey(req.Properties.Value); err != nil {
+		return fmt.Errorf("properties.value: %w", err)
+	}
+	return nil
+}
+
+// Enrich computes the fingerprint of the public key, when Aruba Cloud does not return it
+func (resp *KeyPairResponseDto) Enrich() {
+	if resp.Properties == nil || resp.Properties.Fingerprint != "" {
+		return
+	}
+	if fingerprint, err := validation.SSHFingerprint(resp.Properties.Value); err == nil {
+		resp.Properties.Fingerprint = fingerprint
+	}
+}
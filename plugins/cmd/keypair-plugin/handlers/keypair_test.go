@@ -0,0 +1,150 @@
+package keypair
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// testKey is a key generated with ssh-keygen, testFingerprint its fingerprint printed by ssh-keygen -l
+const (
+	testKey         = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIOp5F45HRkleaokt1SQq0z0RMMQp35IGaTEzD502DDSv user@host"
+	testFingerprint = "SHA256:PCL5/6zjNRPYQeDvPwo1+gJYe3Honyo6LcXx0ktcmjU"
+)
+
+// newTestMux serves the key pair handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	mux := http.NewServeMux()
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Compute/keyPairs", PostKeyPair(opts))
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Compute/keyPairs", ListKeyPairs(opts))
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Compute/keyPairs/{id}", GetKeyPair(opts))
+	return mux, calls
+}
+
+// TestKeyPairHandlers tests the forwarding of the public keys and the fingerprint added to the flattened responses
+func TestKeyPairHandlers(t *testing.T) {
+	const keyPairsURI = "/projects/p1/providers/Aruba.Compute/keyPairs"
+	keyPair := `{"metadata":{"id":"kp1","name":"admin"},"properties":{"value":"` + testKey + `"}}`
+	flattened := `{"id":"kp1","name":"admin","properties":{"fingerprint":"` + testFingerprint + `","value":"` + testKey + `"}}`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		upstreamStatus int
+		upstreamBody   string
+		expectedCall   handlertest.Call
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "create",
+			method:         http.MethodPost,
+			target:         keyPairsURI + "?api-version=1.0",
+			body:           `{"name":"admin","properties":{"value":"` + testKey + `"}}`,
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   keyPair,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: keyPairsURI + "?api-version=1.0", Body: `{"metadata":{"name":"admin"},"properties":{"value":"` + testKey + `"}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "get",
+			method:         http.MethodGet,
+			target:         keyPairsURI + "/kp1?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   keyPair,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: keyPairsURI + "/kp1?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "get keeps the fingerprint of Aruba Cloud",
+			method:         http.MethodGet,
+			target:         keyPairsURI + "/kp1?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"metadata":{"id":"kp1","name":"admin"},"properties":{"value":"` + testKey + `","fingerprint":"SHA256:upstream"}}`,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: keyPairsURI + "/kp1?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":"kp1","name":"admin","properties":{"fingerprint":"SHA256:upstream","value":"` + testKey + `"}}`,
+		},
+		{
+			name:           "list",
+			method:         http.MethodGet,
+			target:         keyPairsURI + "?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"total":1,"values":[` + keyPair + `]}`,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: keyPairsURI + "?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"total":1,"values":[{"id":"kp1","name":"admin","properties":{"value":"` + testKey + `","fingerprint":"` + testFingerprint + `"}}]}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.upstreamStatus)
+				w.Write([]byte(tc.upstreamBody))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 1 || (*calls)[0] != tc.expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", tc.expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tc.expectedBody {
+				t.Errorf("expected body '%s', got '%s'", tc.expectedBody, got)
+			}
+		})
+	}
+}
+
+// TestKeyPairHandlers_Validation tests that the malformed or unsupported public keys are rejected before calling Aruba Cloud
+func TestKeyPairHandlers_Validation(t *testing.T) {
+	const keyPairsURI = "/projects/p1/providers/Aruba.Compute/keyPairs?api-version=1.0"
+	blob := strings.Fields(testKey)[1]
+
+	testCases := []struct {
+		name           string
+		value          string
+		expectedDetail string
+	}{
+		{name: "missing", value: "", expectedDetail: "properties.value is required"},
+		{name: "no key", value: "ssh-ed25519", expectedDetail: "properties.value: invalid SSH public key: expected '<type> <base64 key> [comment]'"},
+		{name: "unsupported type", value: "ssh-dss " + blob, expectedDetail: "properties.value: invalid SSH public key: type must be one of ssh-rsa, ssh-ed25519, ecdsa-sha2-nistp256, ecdsa-sha2-nistp384, ecdsa-sha2-nistp521, got 'ssh-dss'"},
+		{name: "invalid base64", value: "ssh-ed25519 not-base64!", expectedDetail: "properties.value: invalid SSH public key: the key is not valid base64"},
+		{name: "mismatched type", value: "ssh-rsa " + blob, expectedDetail: "properties.value: invalid SSH public key: the key does not match the 'ssh-rsa' type"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+			rec := handlertest.Serve(mux, http.MethodPost, keyPairsURI, `{"name":"admin","properties":{"value":"`+tc.value+`"}}`)
+
+			if len(*calls) != 0 {
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			}
+			if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+				t.Fatalf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+			}
+			var problem handlers.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
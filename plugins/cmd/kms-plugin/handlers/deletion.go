@@ -0,0 +1,115 @@
+package kms
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+)
+
+// StatePendingDeletion is the state reported by Aruba Cloud for the KMS keys whose deletion is scheduled
+const StatePendingDeletion = "PendingDeletion"
+
+// Bounds of the pending window of a KMS key, between the scheduling of its deletion and the deletion
+const (
+	MinPendingWindowDays = 7
+	MaxPendingWindowDays = 30
+)
+
+// pendingWindowDays reads the pending window of the deletion from the pendingWindowInDays query parameter,
+// 0 when it is not set
+func pendingWindowDays(r *http.Request) (int32, error) {
+	value := r.URL.Query().Get("pendingWindowInDays")
+	if value == "" {
+		return 0, nil
+	}
+	days, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || days < MinPendingWindowDays || days > MaxPendingWindowDays {
+		return 0, fmt.Errorf("pendingWindowInDays must be between %d and %d, got '%s'", MinPendingWindowDays, MaxPendingWindowDays, value)
+	}
+	return int32(days), nil
+}
+
+// pendingDeletionCheck answers successfully the deletion requests of the KMS keys that no longer exist
+// or whose deletion is already scheduled, which Aruba Cloud would refuse, so that the deletion of the
+// custom resources can be reconciled. The other requests are handed to the schedule deletion handler.
+type pendingDeletionCheck struct {
+	*handlers.Base
+	next handlers.Handler
+}
+
+// withPendingDeletionCheck returns next preceded by the check of the state of the KMS key
+func withPendingDeletionCheck(opts handlers.HandlerOptions, next handlers.Handler) handlers.Handler {
+	return &pendingDeletionCheck{Base: handlers.NewBase(opts), next: next}
+}
+
+func (h *pendingDeletionCheck) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Invalid requests are answered by the schedule deletion handler
+	projectID, keyID, apiVersion := r.PathValue("projectId"), r.PathValue("id"), r.URL.Query().Get("api-version")
+	if _, err := pendingWindowDays(r); err != nil || projectID == "" || keyID == "" || apiVersion == "" {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	authHeader, err := h.Authorization(r)
+	if err != nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	r = h.WithRequestLogger(r, "projectId", "id")
+	state, found, err := keyState(h.Base, r, authHeader)
+	if err != nil {
+		// The schedule deletion request reports the actual failure, if any
+		h.Logger(r).Error("Failed to check the state of KMS key", err)
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	switch {
+	case !found:
+		h.WriteNoContentResponse(w)
+		h.Logger(r).Info("KMS key not found, considering it already deleted")
+	case state == StatePendingDeletion || state == handlers.StateDeleted:
+		h.WriteNoContentResponse(w)
+		h.Logger(r).Info(fmt.Sprintf("KMS key is already in '%s' state, considering its deletion scheduled", state))
+	default:
+		h.next.ServeHTTP(w, r)
+	}
+}
+
+// keyState reads the state of the KMS key of the request, found is false when it does not exist
+func keyState(h *handlers.Base, r *http.Request, authHeader string) (state string, found bool, err error) {
+	ctx, cancel := h.Timeouts.WithUpstreamTimeout(r.Context(), handlers.OperationGet)
+	defer cancel()
+
+	resp, err := h.MakeArubaCloudRequest(ctx, KmsKey.Operation("get"), http.MethodGet, KmsKey.ItemURL(h.BaseURL, r, ""), authHeader, nil)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to make get KMS key request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", false, fmt.Errorf("Aruba Cloud API returned non-200 status for get KMS key: %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read get KMS key response: %w", err)
+	}
+	var key KmsKeyResponseDto
+	if err := json.Unmarshal(body, &key); err != nil {
+		return "", false, fmt.Errorf("failed to unmarshal Aruba Cloud response: %w", err)
+	}
+	if key.Status == nil {
+		return "", true, nil
+	}
+	return key.Status.State, true, nil
+}
+
+// Interface compliance verification
+var _ handlers.Handler = &pendingDeletionCheck{}
@@ -0,0 +1,175 @@
+package kms
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// newTestMux serves the KMS key handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	mux := http.NewServeMux()
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Security/kmsKeys", ListKmsKeys(opts))
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id}", GetKmsKey(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id}", PutKmsKey(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id}/disable", DisableKmsKey(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id}/enable", EnableKmsKey(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id}/scheduleDeletion", ScheduleKmsKeyDeletion(opts))
+	return mux, calls
+}
+
+// TestScheduleKmsKeyDeletion tests the idempotent scheduling of the deletion of the KMS keys and the forwarding of the pending window
+func TestScheduleKmsKeyDeletion(t *testing.T) {
+	const keyURI = "/projects/p1/providers/Aruba.Security/kmsKeys/k1"
+
+	testCases := []struct {
+		name           string
+		target         string
+		getStatus      int
+		getBody        string
+		scheduleStatus int
+		expectedCalls  []handlertest.Call
+		expectedStatus int
+	}{
+		{
+			name:           "not found",
+			target:         keyURI + "/scheduleDeletion?api-version=1.0",
+			getStatus:      http.StatusNotFound,
+			expectedCalls:  []handlertest.Call{{Method: http.MethodGet, URI: keyURI + "?api-version=1.0"}},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "already pending deletion",
+			target:         keyURI + "/scheduleDeletion?api-version=1.0&pendingWindowInDays=7",
+			getStatus:      http.StatusOK,
+			getBody:        `{"metadata":{"id":"k1"},"status":{"state":"PendingDeletion"}}`,
+			expectedCalls:  []handlertest.Call{{Method: http.MethodGet, URI: keyURI + "?api-version=1.0"}},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "already deleted",
+			target:         keyURI + "/scheduleDeletion?api-version=1.0",
+			getStatus:      http.StatusOK,
+			getBody:        `{"metadata":{"id":"k1"},"status":{"state":"Deleted"}}`,
+			expectedCalls:  []handlertest.Call{{Method: http.MethodGet, URI: keyURI + "?api-version=1.0"}},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "active",
+			target:         keyURI + "/scheduleDeletion?api-version=1.0",
+			getStatus:      http.StatusOK,
+			getBody:        `{"metadata":{"id":"k1"},"status":{"state":"Active"}}`,
+			scheduleStatus: http.StatusAccepted,
+			expectedCalls: []handlertest.Call{
+				{Method: http.MethodGet, URI: keyURI + "?api-version=1.0"},
+				{Method: http.MethodPost, URI: keyURI + "/scheduleDeletion?api-version=1.0"},
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "disabled with a pending window",
+			target:         keyURI + "/scheduleDeletion?api-version=1.0&pendingWindowInDays=30",
+			getStatus:      http.StatusOK,
+			getBody:        `{"metadata":{"id":"k1"},"status":{"state":"Disabled"}}`,
+			scheduleStatus: http.StatusAccepted,
+			expectedCalls: []handlertest.Call{
+				{Method: http.MethodGet, URI: keyURI + "?api-version=1.0"},
+				{Method: http.MethodPost, URI: keyURI + "/scheduleDeletion?api-version=1.0", Body: `{"pendingWindowInDays":30}`},
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "state not available",
+			target:         keyURI + "/scheduleDeletion?api-version=1.0",
+			getStatus:      http.StatusServiceUnavailable,
+			scheduleStatus: http.StatusAccepted,
+			expectedCalls: []handlertest.Call{
+				{Method: http.MethodGet, URI: keyURI + "?api-version=1.0"},
+				{Method: http.MethodPost, URI: keyURI + "/scheduleDeletion?api-version=1.0"},
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "refused",
+			target:         keyURI + "/scheduleDeletion?api-version=1.0",
+			getStatus:      http.StatusOK,
+			getBody:        `{"metadata":{"id":"k1"},"status":{"state":"InUse"}}`,
+			scheduleStatus: http.StatusConflict,
+			expectedCalls: []handlertest.Call{
+				{Method: http.MethodGet, URI: keyURI + "?api-version=1.0"},
+				{Method: http.MethodPost, URI: keyURI + "/scheduleDeletion?api-version=1.0"},
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPost {
+					w.WriteHeader(tc.scheduleStatus)
+					return
+				}
+				w.WriteHeader(tc.getStatus)
+				w.Write([]byte(tc.getBody))
+			})
+
+			rec := handlertest.Serve(mux, http.MethodPost, tc.target, "")
+
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if len(*calls) != len(tc.expectedCalls) {
+				t.Fatalf("expected the upstream calls %+v, got %+v", tc.expectedCalls, *calls)
+			}
+			for i, call := range tc.expectedCalls {
+				if (*calls)[i] != call {
+					t.Errorf("expected the upstream call %+v, got %+v", call, (*calls)[i])
+				}
+			}
+		})
+	}
+}
+
+// TestScheduleKmsKeyDeletion_Validation tests that invalid requests are rejected before calling Aruba Cloud
+func TestScheduleKmsKeyDeletion_Validation(t *testing.T) {
+	const keyURI = "/projects/p1/providers/Aruba.Security/kmsKeys/k1"
+
+	testCases := []struct {
+		name           string
+		target         string
+		expectedDetail string
+	}{
+		{name: "missing API version", target: keyURI + "/scheduleDeletion", expectedDetail: "API version parameter is required"},
+		{name: "pending window too short", target: keyURI + "/scheduleDeletion?api-version=1.0&pendingWindowInDays=6", expectedDetail: "pendingWindowInDays must be between 7 and 30, got '6'"},
+		{name: "pending window too long", target: keyURI + "/scheduleDeletion?api-version=1.0&pendingWindowInDays=31", expectedDetail: "pendingWindowInDays must be between 7 and 30, got '31'"},
+		{name: "pending window not a number", target: keyURI + "/scheduleDeletion?api-version=1.0&pendingWindowInDays=week", expectedDetail: "pendingWindowInDays must be between 7 and 30, got 'week'"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+			rec := handlertest.Serve(mux, http.MethodPost, tc.target, "")
+
+			if len(*calls) != 0 {
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			}
+			if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+				t.Fatalf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+			}
+			var problem handlers.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
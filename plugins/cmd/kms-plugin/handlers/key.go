@@ -0,0 +1,281 @@
+package kms
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// KmsKey is the Aruba Cloud customer-managed encryption key resource of the Key Management Service (KMS)
+var KmsKey = handlers.Resource{
+	Name:          "KMS key",
+	Plural:        "KMS keys",
+	Path:          "/projects/{projectId}/providers/Aruba.Security/kmsKeys",
+	PathParams:    []handlers.Param{handlers.ProjectIDParam},
+	IDParam:       handlers.Param{Name: "id", Label: "KMS Key ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetKmsKey
+// @Summary Get a KMS key from Aruba Cloud
+// @Description Get a KMS key from Aruba Cloud using the provided project and KMS key details.
+// @Description The state of the key is reported in status.state, e.g. Enabled, Disabled or PendingDeletion, and the date of a scheduled deletion in properties.deletionDate.
+// @Description The key material is never part of the response.
+// @ID get-kms-key
+// @Param projectId path string true "Project ID"
+// @Param id path string true "KMS Key ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedKmsKeyResponseDto "KMS key details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id} [get]
+func GetKmsKey(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[KmsKeyResponseDto](opts, KmsKey)
+}
+
+// PostKmsKey
+// @Summary Create a new KMS key on Aruba Cloud
+// @Description Create a new KMS key on Aruba Cloud using the provided project details.
+// @Description The key material is generated by Aruba Cloud and never leaves the KMS.
+// @ID post-kms-key
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param kmsKeyCreate body FlattenedCreateKmsKeyRequestDto true "KMS key creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedKmsKeyResponseDto "KMS key details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Security/kmsKeys [post]
+func PostKmsKey(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateKmsKeyRequestDto, KmsKeyDto, KmsKeyResponseDto](opts, KmsKey,
+		func(req FlattenedCreateKmsKeyRequestDto) KmsKeyDto {
+			return KmsKeyDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutKmsKey
+// @Summary Update a KMS key on Aruba Cloud
+// @Description Update a KMS key on Aruba Cloud using the provided project and KMS key details.
+// @Description Only the description and the automatic rotation of a KMS key can be changed, its algorithm and usage are fixed at creation.
+// @Description When enabled differs from the state of the key, the key is enabled or disabled before it is updated, see the enable and disable endpoints.
+// @Description KMS keys are not deleted immediately, see the scheduleDeletion endpoint.
+// @ID put-kms-key
+// @Param projectId path string true "Project ID"
+// @Param id path string true "KMS Key ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param kmsKeyUpdate body FlattenedUpdateKmsKeyRequestDto true "KMS key update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedKmsKeyResponseDto "KMS key details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id} [put]
+func PutKmsKey(opts handlers.HandlerOptions) handlers.Handler {
+	return withEnabledState(opts, handlers.Update[FlattenedUpdateKmsKeyRequestDto, KmsKeyUpdateDto, KmsKeyResponseDto](opts, KmsKey,
+		func(req FlattenedUpdateKmsKeyRequestDto) KmsKeyUpdateDto {
+			return KmsKeyUpdateDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		}))
+}
+
+// ListKmsKeys
+// @Summary List KMS keys on Aruba Cloud
+// @Description List KMS keys on Aruba Cloud using the provided project details.
+// @ID list-kms-keys
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedKmsKeyListResponseDto "A list of KMS keys"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Security/kmsKeys [get]
+func ListKmsKeys(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[KmsKeyResponseDto, FlattenedKmsKeyResponseDto](opts, KmsKey)
+}
+
+// DisableKmsKey
+// @Summary Disable a KMS key on Aruba Cloud
+// @Description Disable a KMS key on Aruba Cloud using the provided project and KMS key details.
+// @Description A disabled key cannot be used to encrypt nor decrypt, the data it encrypted stays unreadable until it is enabled again.
+// @ID disable-kms-key
+// @Param projectId path string true "Project ID"
+// @Param id path string true "KMS Key ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedKmsKeyResponseDto "KMS key details"
+// @Success 202 "Accepted"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 409 {object} handlers.ProblemDetails "Conflict"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id}/disable [post]
+func DisableKmsKey(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Action[KmsKeyResponseDto](opts, KmsKey, actionDisable, actionDisable)
+}
+
+// EnableKmsKey
+// @Summary Enable a KMS key on Aruba Cloud
+// @Description Enable a disabled KMS key on Aruba Cloud using the provided project and KMS key details.
+// @Description A key pending deletion cannot be enabled.
+// @ID enable-kms-key
+// @Param projectId path string true "Project ID"
+// @Param id path string true "KMS Key ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedKmsKeyResponseDto "KMS key details"
+// @Success 202 "Accepted"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 409 {object} handlers.ProblemDetails "Conflict"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id}/enable [post]
+func EnableKmsKey(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Action[KmsKeyResponseDto](opts, KmsKey, actionEnable, actionEnable)
+}
+
+// ScheduleKmsKeyDeletion
+// @Summary Schedule the deletion of a KMS key on Aruba Cloud
+// @Description Schedule the deletion of a KMS key on Aruba Cloud using the provided project and KMS key details.
+// @Description The key is not removed immediately: it moves to the PendingDeletion state and is deleted by Aruba Cloud at properties.deletionDate, at the end of its pending window.
+// @Description Scheduling the deletion of a key that does not exist or is already pending deletion is considered successful.
+// @ID schedule-kms-key-deletion
+// @Param projectId path string true "Project ID"
+// @Param id path string true "KMS Key ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param pendingWindowInDays query integer false "Days before the key is deleted, from 7 to 30, the default of Aruba Cloud if empty"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedKmsKeyResponseDto "KMS key details"
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 409 {object} handlers.ProblemDetails "Conflict"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id}/scheduleDeletion [post]
+func ScheduleKmsKeyDeletion(opts handlers.HandlerOptions) handlers.Handler {
+	return withPendingDeletionCheck(opts, handlers.ActionWithBody[KmsKeyResponseDto](opts, KmsKey, "schedule deletion", "scheduleDeletion",
+		func(r *http.Request) (any, error) {
+			days, err := pendingWindowDays(r)
+			if err != nil || days == 0 {
+				return nil, err
+			}
+			return ScheduleKmsKeyDeletionDto{PendingWindowInDays: days}, nil
+		}))
+}
+
+// Algorithms and usages of the KMS keys
+const (
+	AlgorithmAES256  = "AES256"
+	AlgorithmRSA2048 = "RSA2048"
+	AlgorithmRSA3072 = "RSA3072"
+	AlgorithmRSA4096 = "RSA4096"
+
+	UsageEncryptDecrypt = "EncryptDecrypt"
+	UsageSignVerify     = "SignVerify"
+)
+
+// Bounds of the automatic rotation period of the symmetric keys
+const (
+	MinRotationPeriodDays = 90
+	MaxRotationPeriodDays = 2560
+)
+
+// Enrich reports whether the key is enabled, from its state, so that the enabled field of the KmsKey resources can be compared
+func (resp *KmsKeyResponseDto) Enrich() {
+	if resp.Status == nil || (resp.Status.State != StateEnabled && resp.Status.State != StateDisabled) {
+		return
+	}
+	enabled := resp.Status.State == StateEnabled
+	resp.Enabled = &enabled
+}
+
+// Validate checks the algorithm, the usage and the rotation of the KMS key before it is sent to Aruba Cloud
+func (req FlattenedCreateKmsKeyRequestDto) Validate() error {
+	props := req.Properties
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if err := validation.OneOf("properties.algorithm", props.Algorithm, AlgorithmAES256, AlgorithmRSA2048, AlgorithmRSA3072, AlgorithmRSA4096); err != nil {
+		return err
+	}
+	if err := validation.OneOf("properties.usage", props.Usage, UsageEncryptDecrypt, UsageSignVerify); err != nil {
+		return err
+	}
+	if props.Algorithm == AlgorithmAES256 && props.Usage != UsageEncryptDecrypt {
+		return fmt.Errorf("properties.usage must be %s for %s keys, got '%s'", UsageEncryptDecrypt, AlgorithmAES256, props.Usage)
+	}
+	if props.Rotation != nil && props.Rotation.Enabled && props.Algorithm != AlgorithmAES256 {
+		return fmt.Errorf("properties.rotation can only be enabled for %s keys", AlgorithmAES256)
+	}
+	return validateRotation(props.Rotation)
+}
+
+// Validate checks the rotation of the KMS key before it is sent to Aruba Cloud
+func (req FlattenedUpdateKmsKeyRequestDto) Validate() error {
+	if req.Properties == nil {
+		return nil
+	}
+	return validateRotation(req.Properties.Rotation)
+}
+
+func validateRotation(rotation *RotationDto) error {
+	if rotation == nil || !rotation.Enabled {
+		return nil
+	}
+	if rotation.PeriodDays < MinRotationPeriodDays || rotation.PeriodDays > MaxRotationPeriodDays {
+		return fmt.Errorf("properties.rotation.periodDays must be between %d and %d, got %d", MinRotationPeriodDays, MaxRotationPeriodDays, rotation.PeriodDays)
+	}
+	return nil
+}
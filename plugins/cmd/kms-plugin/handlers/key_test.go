@@ -0,0 +1,198 @@
+package kms
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// TestKmsKeyEnabledState tests the enabling and disabling of the KMS keys, directly and through the updates
+func TestKmsKeyEnabledState(t *testing.T) {
+	const keyURI = "/projects/p1/providers/Aruba.Security/kmsKeys/k1"
+	const query = "?api-version=1.0"
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		state          string
+		actionStatus   int
+		expectedCalls  []handlertest.Call
+		expectedStatus int
+	}{
+		{
+			name:         "update disabling an enabled key",
+			method:       http.MethodPut,
+			target:       keyURI + query,
+			body:         `{"properties":{"description":"db"},"enabled":false}`,
+			state:        StateEnabled,
+			actionStatus: http.StatusOK,
+			expectedCalls: []handlertest.Call{
+				{Method: http.MethodGet, URI: keyURI + query},
+				{Method: http.MethodPost, URI: keyURI + "/disable" + query},
+				{Method: http.MethodPut, URI: keyURI + query, Body: `{"metadata":{},"properties":{"description":"db"}}`},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:         "update enabling a disabled key",
+			method:       http.MethodPut,
+			target:       keyURI + query,
+			body:         `{"properties":{"description":"db"},"enabled":true}`,
+			state:        StateDisabled,
+			actionStatus: http.StatusOK,
+			expectedCalls: []handlertest.Call{
+				{Method: http.MethodGet, URI: keyURI + query},
+				{Method: http.MethodPost, URI: keyURI + "/enable" + query},
+				{Method: http.MethodPut, URI: keyURI + query, Body: `{"metadata":{},"properties":{"description":"db"}}`},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "update keeping the state",
+			method: http.MethodPut,
+			target: keyURI + query,
+			body:   `{"properties":{"description":"db"},"enabled":true}`,
+			state:  StateEnabled,
+			expectedCalls: []handlertest.Call{
+				{Method: http.MethodGet, URI: keyURI + query},
+				{Method: http.MethodPut, URI: keyURI + query, Body: `{"metadata":{},"properties":{"description":"db"}}`},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "update without enabled",
+			method: http.MethodPut,
+			target: keyURI + query,
+			body:   `{"properties":{"description":"db"}}`,
+			state:  StateEnabled,
+			expectedCalls: []handlertest.Call{
+				{Method: http.MethodPut, URI: keyURI + query, Body: `{"metadata":{},"properties":{"description":"db"}}`},
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:         "update failing to disable",
+			method:       http.MethodPut,
+			target:       keyURI + query,
+			body:         `{"properties":{"description":"db"},"enabled":false}`,
+			state:        StateEnabled,
+			actionStatus: http.StatusConflict,
+			expectedCalls: []handlertest.Call{
+				{Method: http.MethodGet, URI: keyURI + query},
+				{Method: http.MethodPost, URI: keyURI + "/disable" + query},
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "disable",
+			method:         http.MethodPost,
+			target:         keyURI + "/disable" + query,
+			actionStatus:   http.StatusOK,
+			expectedCalls:  []handlertest.Call{{Method: http.MethodPost, URI: keyURI + "/disable" + query}},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "enable",
+			method:         http.MethodPost,
+			target:         keyURI + "/enable" + query,
+			actionStatus:   http.StatusOK,
+			expectedCalls:  []handlertest.Call{{Method: http.MethodPost, URI: keyURI + "/enable" + query}},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.Method {
+				case http.MethodPost:
+					w.WriteHeader(tc.actionStatus)
+					if tc.actionStatus != http.StatusOK {
+						w.Write([]byte(`{"title":"Conflict","status":409}`))
+						return
+					}
+					w.Write([]byte(`{"metadata":{"id":"k1"}}`))
+				default:
+					w.WriteHeader(http.StatusOK)
+					w.Write([]byte(`{"metadata":{"id":"k1"},"status":{"state":"` + tc.state + `"}}`))
+				}
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if len(*calls) != len(tc.expectedCalls) {
+				t.Fatalf("expected the upstream calls %+v, got %+v", tc.expectedCalls, *calls)
+			}
+			for i, call := range tc.expectedCalls {
+				if (*calls)[i] != call {
+					t.Errorf("expected the upstream call %+v, got %+v", call, (*calls)[i])
+				}
+			}
+		})
+	}
+}
+
+// TestKmsKeyEnrich tests that the get and list responses report whether the KMS keys are enabled
+func TestKmsKeyEnrich(t *testing.T) {
+	const keysURI = "/projects/p1/providers/Aruba.Security/kmsKeys"
+
+	testCases := []struct {
+		name         string
+		target       string
+		upstreamBody string
+		expected     string
+	}{
+		{
+			name:         "get enabled",
+			target:       keysURI + "/k1?api-version=1.0",
+			upstreamBody: `{"metadata":{"id":"k1"},"status":{"state":"Enabled"}}`,
+			expected:     `"enabled":true`,
+		},
+		{
+			name:         "get disabled",
+			target:       keysURI + "/k1?api-version=1.0",
+			upstreamBody: `{"metadata":{"id":"k1"},"status":{"state":"Disabled"}}`,
+			expected:     `"enabled":false`,
+		},
+		{
+			name:         "list",
+			target:       keysURI + "?api-version=1.0",
+			upstreamBody: `{"total":1,"values":[{"metadata":{"id":"k1"},"status":{"state":"Disabled"}}]}`,
+			expected:     `"enabled":false`,
+		},
+		{
+			name:         "get pending deletion",
+			target:       keysURI + "/k1?api-version=1.0",
+			upstreamBody: `{"metadata":{"id":"k1"},"status":{"state":"PendingDeletion"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, _ := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tc.upstreamBody))
+			})
+
+			rec := handlertest.Serve(mux, http.MethodGet, tc.target, "")
+
+			if rec.Code != http.StatusOK {
+				t.Fatalf("expected status 200, got %d", rec.Code)
+			}
+			body := rec.Body.String()
+			if tc.expected == "" && strings.Contains(body, `"enabled"`) {
+				t.Errorf("did not expect enabled in '%s'", body)
+			}
+			if tc.expected != "" && !strings.Contains(body, tc.expected) {
+				t.Errorf("expected '%s' in '%s'", tc.expected, body)
+			}
+		})
+	}
+}
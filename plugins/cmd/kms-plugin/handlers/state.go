@@ -0,0 +1,112 @@
+package kms
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/logging"
+)
+
+// States reported by Aruba Cloud for the KMS keys that can be used or not
+const (
+	StateEnabled  = "Enabled"
+	StateDisabled = "Disabled"
+)
+
+// Actions enabling and disabling the KMS keys, also the paths of the actions
+const (
+	actionEnable  = "enable"
+	actionDisable = "disable"
+)
+
+// enabledStateSync enables or disables the KMS key of an update request when its enabled field differs from the state
+// of the key, before handing the request to the update handler. The RestDefinition can only update the KmsKey resources,
+// so that the enable and disable actions are reached through the updates.
+type enabledStateSync struct {
+	*handlers.Base
+	next handlers.Handler
+}
+
+// withEnabledState returns next preceded by the enabling or disabling of the KMS key
+func withEnabledState(opts handlers.HandlerOptions, next handlers.Handler) handlers.Handler {
+	return &enabledStateSync{Base: handlers.NewBase(opts), next: next}
+}
+
+func (h *enabledStateSync) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.WriteErrorResponse(w, r, http.StatusBadRequest, "Failed to read request body")
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	// Invalid requests, and requests leaving the state unchanged, are answered by the update handler
+	var req FlattenedUpdateKmsKeyRequestDto
+	if err := json.Unmarshal(body, &req); err != nil || req.Enabled == nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	if r.PathValue("projectId") == "" || r.PathValue("id") == "" || r.URL.Query().Get("api-version") == "" {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	authHeader, err := h.Authorization(r)
+	if err != nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	r = h.WithRequestLogger(r, "projectId", "id")
+	state, found, err := keyState(h.Base, r, authHeader)
+	if err != nil {
+		// The update request reports the actual failure, if any
+		h.Logger(r).Error("Failed to check the state of KMS key", err)
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	action := ""
+	switch {
+	case found && *req.Enabled && state == StateDisabled:
+		action = actionEnable
+	case found && !*req.Enabled && state == StateEnabled:
+		action = actionDisable
+	}
+	if action != "" && !h.apply(w, r, action, authHeader) {
+		return
+	}
+	h.next.ServeHTTP(w, r)
+}
+
+// apply requests the action on the KMS key, writing the error response and returning false on failure
+func (h *enabledStateSync) apply(w http.ResponseWriter, r *http.Request, action, authHeader string) bool {
+	ctx, cancel := h.Timeouts.WithUpstreamTimeout(r.Context(), handlers.OperationUpdate)
+	defer cancel()
+
+	resp, err := h.MakeArubaCloudRequest(ctx, KmsKey.Operation(action), http.MethodPost, KmsKey.ItemURL(h.BaseURL, r, action), authHeader, nil)
+	if err != nil {
+		h.WriteUpstreamFailure(w, r, err, fmt.Sprintf("Failed to make %s KMS key request", action))
+		return false
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		h.WriteUpstreamFailure(w, r, err, fmt.Sprintf("Failed to read %s KMS key response", action))
+		return false
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		h.Logger(r).Warn(fmt.Sprintf("Aruba Cloud API returned non-2xx status for %s KMS key", action), logging.Fields{logging.FieldUpstreamStatus: resp.StatusCode, logging.FieldUpstreamBody: logging.RedactBody(respBody)})
+		h.WriteUpstreamErrorResponse(w, r, resp.StatusCode, respBody)
+		return false
+	}
+	h.Logger(r).Info(fmt.Sprintf("Successfully requested %s KMS key", action))
+	return true
+}
+
+// Interface compliance verification
+var _ handlers.Handler = &enabledStateSync{}
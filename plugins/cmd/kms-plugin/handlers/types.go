@@ -0,0 +1,261 @@
+package kms
+
+type KmsKeyDto struct {
+	Metadata   *MetadataDto         `json:"metadata,omitempty"`
+	Properties *KmsKeyPropertiesDto `json:"properties,omitempty"`
+}
+
+type KmsKeyUpdateDto struct {
+	Metadata   *MetadataDto               `json:"metadata,omitempty"`
+	Properties *KmsKeyUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+// ScheduleKmsKeyDeletionDto is the body of the deletion scheduling of a KMS key, sent only with a pending window
+type ScheduleKmsKeyDeletionDto struct {
+	// PendingWindowInDays is the number of days before the key is deleted.
+	PendingWindowInDays int32 `json:"pendingWindowInDays,omitempty"`
+}
+
+type MetadataDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+}
+
+type LocationDto struct {
+	// Value is the region where the resource will be located.
+	// Available regions at present: ITBG-Bergamo.
+	Value string `json:"value,omitempty"`
+}
+
+type KmsKeyPropertiesDto struct {
+	// Description is the purpose of the key, e.g. the volumes or databases it encrypts.
+	Description string `json:"description,omitempty"`
+	// Algorithm is the algorithm of the key.
+	// Allowed values: AES256, RSA2048, RSA3072, RSA4096.
+	Algorithm string `json:"algorithm,omitempty"`
+	// Usage is the usage of the key, EncryptDecrypt for AES256 keys.
+	// Allowed values: EncryptDecrypt, SignVerify.
+	Usage string `json:"usage,omitempty"`
+	// Rotation is the automatic rotation of the key material, for AES256 keys only.
+	Rotation *RotationDto `json:"rotation,omitempty"`
+}
+
+type KmsKeyUpdatePropertiesDto struct {
+	// Description is the purpose of the key.
+	Description string `json:"description,omitempty"`
+	// Rotation is the automatic rotation of the key material, for AES256 keys only.
+	Rotation *RotationDto `json:"rotation,omitempty"`
+}
+
+type RotationDto struct {
+	// Enabled indicates if the key material is rotated automatically.
+	Enabled bool `json:"enabled,omitempty"`
+	// PeriodDays is the number of days between two rotations, between 90 and 2560.
+	PeriodDays int32 `json:"periodDays,omitempty"`
+}
+
+type KmsKeyResponseDto struct {
+	Metadata   *MetadataResponseDto         `json:"metadata,omitempty"`
+	Status     *StatusResponseDto           `json:"status,omitempty"`
+	Properties *KmsKeyPropertiesResponseDto `json:"properties,omitempty"`
+	// Enabled is computed by the plugin from the state of the key, see Enrich.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+type MetadataResponseDto struct {
+	ID           string               `json:"id,omitempty"`
+	URI          string               `json:"uri,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	Location     *LocationResponseDto `json:"location,omitempty"`
+	Project      *ProjectResponseDto  `json:"project,omitempty"`
+	Tags         []string             `json:"tags,omitempty"`
+	Category     *CategoryResponseDto `json:"category,omitempty"`
+	CreationDate string               `json:"creationDate,omitempty"`
+	CreatedBy    string               `json:"createdBy,omitempty"`
+	UpdateDate   string               `json:"updateDate,omitempty"`
+	UpdatedBy    string               `json:"updatedBy,omitempty"`
+	Version      string               `json:"version,omitempty"`
+	CreatedUser  string               `json:"createdUser,omitempty"`
+	UpdatedUser  string               `json:"updatedUser,omitempty"`
+}
+
+type LocationResponseDto struct {
+	// Code is the code of the region.
+	Code string `json:"code,omitempty"`
+	// Country is the country of the region.
+	Country string `json:"country,omitempty"`
+	// City is the city of the region.
+	City string `json:"city,omitempty"`
+	// Name is the name of the region.
+	Name string `json:"name,omitempty"`
+	// Value is the value of the region.
+	Value string `json:"value,omitempty"`
+}
+
+type ProjectResponseDto struct {
+	// ID is the unique identifier of the project.
+	ID string `json:"id,omitempty"`
+}
+
+type CategoryResponseDto struct {
+	// Name is the name of the category.
+	Name string `json:"name,omitempty"`
+	// Provider is the provider of the category.
+	Provider string `json:"provider,omitempty"`
+	// Typology is the typology of the category.
+	Typology *TypologyResponseDto `json:"typology,omitempty"`
+}
+
+type TypologyResponseDto struct {
+	// ID is the unique identifier of the typology.
+	ID string `json:"id,omitempty"`
+	// Name is the name of the typology.
+	Name string `json:"name,omitempty"`
+}
+
+type StatusResponseDto struct {
+	// State is the state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the status.
+	CreationDate string `json:"creationDate,omitempty"`
+	// DisableStatusInfo contains the information about the disabled status of the resource.
+	DisableStatusInfo *DisableStatusInfoResponseDto `json:"disableStatusInfo,omitempty"`
+	// FailureReason is the reason of the failure, if any.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+type DisableStatusInfoResponseDto struct {
+	// IsDisabled indicates if the resource is disabled.
+	IsDisabled bool `json:"isDisabled,omitempty"`
+	// Reasons is a list of reasons for the disabled status.
+	Reasons []string `json:"reasons,omitempty"`
+	// PreviousStatus is the previous status of the resource.
+	PreviousStatus *PreviousStatusResponseDto `json:"previousStatus,omitempty"`
+}
+
+type PreviousStatusResponseDto struct {
+	// State is the previous state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the previous status.
+	CreationDate string `json:"creationDate,omitempty"`
+}
+
+// KmsKeyPropertiesResponseDto are the properties of a KMS key returned by Aruba Cloud.
+// The key material is left out, so that it is never part of the responses.
+type KmsKeyPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the key, e.g. the volumes it encrypts.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Description is the purpose of the key.
+	Description string `json:"description,omitempty"`
+	// Algorithm is the algorithm of the key.
+	Algorithm string `json:"algorithm,omitempty"`
+	// Usage is the usage of the key.
+	Usage string `json:"usage,omitempty"`
+	// Rotation is the automatic rotation of the key material.
+	Rotation *RotationDto `json:"rotation,omitempty"`
+	// LastRotation is the time of the last rotation of the key material, in RFC 3339 format.
+	LastRotation string `json:"lastRotation,omitempty"`
+	// DeletionDate is the time at which a key pending deletion is deleted, in RFC 3339 format.
+	DeletionDate string `json:"deletionDate,omitempty"`
+}
+
+type LinkedResourceResponseDto struct {
+	// URI is the URI of the linked resource.
+	URI string `json:"uri,omitempty"`
+	// StrictCorrelation indicates if the correlation is strict.
+	StrictCorrelation bool `json:"strictCorrelation,omitempty"`
+}
+
+// --------------------------------------------------------------------------
+// Flattened types
+// --------------------------------------------------------------------------
+
+// FlattenedCreateKmsKeyRequestDto is the flattened request body for creating a KMS key.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateKmsKeyRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the KMS key.
+	Properties *KmsKeyPropertiesDto `json:"properties,omitempty"`
+	// Enabled indicates if the key can be used, it is applied by the updates of the key.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// FlattenedUpdateKmsKeyRequestDto is the flattened request body for updating a KMS key.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateKmsKeyRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the KMS key.
+	Properties *KmsKeyUpdatePropertiesDto `json:"properties,omitempty"`
+	// Enabled indicates if the key can be used, the key is enabled or disabled when it differs from its state.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// FlattenedKmsKeyResponseDto is the flattened response body for a single KMS key.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedKmsKeyResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the KMS key.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the KMS key.
+	Properties *KmsKeyPropertiesResponseDto `json:"properties,omitempty"`
+	// Enabled indicates if the key is enabled or disabled, missing in the other states.
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+type FlattenedKmsKeyListResponseDto struct {
+	// Total is the total number of KMS keys.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened KMS keys.
+	Values []FlattenedKmsKeyResponseDto `json:"values,omitempty"`
+}
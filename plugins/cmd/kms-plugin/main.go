@@ -0,0 +1,51 @@
+package main
+
+import (
+	kms "github.com/krateoplatformops/arubacloud-provider-kog/kms-plugin/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/health"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/server"
+	httpSwagger "github.com/swaggo/http-swagger"
+)
+
+// @title           Aruba Cloud KMS Plugin API for Krateo Operator Generator (KOG)
+// @version         1.0
+// @description     Simple wrapper around Aruba Cloud API to provide consistency of API response for Krateo Operator Generator (KOG)
+// @termsOfService  http://swagger.io/terms/
+// @contact.name    Krateo Support
+// @contact.url     https://krateo.io
+// @contact.email   contact@krateoplatformops.io
+// @license.name    Apache 2.0
+// @license.url     http://www.apache.org/licenses/LICENSE-2.0.html
+// @host            localhost:8080
+// @BasePath        /
+// @schemes         http
+func main() {
+	srv := server.New()
+
+	opts := handlers.HandlerOptions{
+		Log:      srv.Logger(),
+		Client:   srv.HTTPClient(),
+		BaseURL:  srv.BaseURL(),
+		Auth:     srv.TokenProvider(),
+		Timeouts: srv.UpstreamTimeouts(),
+	}
+
+	// KMS key
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Security/kmsKeys", kms.PostKmsKey(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Security/kmsKeys", kms.ListKmsKeys(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id}", kms.GetKmsKey(opts))
+	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id}", kms.PutKmsKey(opts))
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id}/disable", kms.DisableKmsKey(opts))
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id}/enable", kms.EnableKmsKey(opts))
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Security/kmsKeys/{id}/scheduleDeletion", kms.ScheduleKmsKeyDeletion(opts))
+
+	// Swagger UI
+	srv.Mux().Handle("/swagger/", httpSwagger.WrapHandler)
+
+	// Kubernetes health check endpoints
+	srv.Mux().HandleFunc("GET /healthz", health.LivenessHandler(srv.Healthy()))
+	srv.Mux().HandleFunc("GET /readyz", srv.ReadinessHandler())
+
+	srv.Run()
+}
@@ -0,0 +1,234 @@
+package loadbalancer
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// LoadBalancerBackendPool is the Aruba Cloud backend pool resource of a load balancer, balancing the traffic on cloud servers of a subnet
+var LoadBalancerBackendPool = handlers.Resource{
+	Name:   "backend pool",
+	Plural: "backend pools",
+	Path:   "/projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/backendPools",
+	PathParams: []handlers.Param{
+		handlers.ProjectIDParam,
+		{Name: "loadBalancerId", Label: "Load Balancer ID"},
+	},
+	IDParam:       handlers.Param{Name: "id", Label: "Backend Pool ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetLoadBalancerBackendPool
+// @Summary Get a backend pool from Aruba Cloud
+// @Description Get a backend pool from Aruba Cloud using the provided project, load balancer and backend pool details.
+// @Description The health of every target, as reported by the health check, is in properties.targets[].health.
+// @ID get-load-balancer-backend-pool
+// @Param projectId path string true "Project ID"
+// @Param loadBalancerId path string true "Load Balancer ID"
+// @Param id path string true "Backend Pool ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedLoadBalancerBackendPoolResponseDto "Backend pool details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/backendPools/{id} [get]
+func GetLoadBalancerBackendPool(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[LoadBalancerBackendPoolResponseDto](opts, LoadBalancerBackendPool)
+}
+
+// PostLoadBalancerBackendPool
+// @Summary Create a new backend pool on Aruba Cloud
+// @Description Create a new backend pool on Aruba Cloud using the provided project and load balancer details.
+// @ID post-load-balancer-backend-pool
+// @Param projectId path string true "Project ID"
+// @Param loadBalancerId path string true "Load Balancer ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param loadBalancerBackendPoolCreate body FlattenedCreateLoadBalancerBackendPoolRequestDto true "Backend pool creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedLoadBalancerBackendPoolResponseDto "Backend pool details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/backendPools [post]
+func PostLoadBalancerBackendPool(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateLoadBalancerBackendPoolRequestDto, LoadBalancerBackendPoolDto, LoadBalancerBackendPoolResponseDto](opts, LoadBalancerBackendPool,
+		func(req FlattenedCreateLoadBalancerBackendPoolRequestDto) LoadBalancerBackendPoolDto {
+			return LoadBalancerBackendPoolDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutLoadBalancerBackendPool
+// @Summary Update a backend pool on Aruba Cloud
+// @Description Update a backend pool on Aruba Cloud using the provided project, load balancer and backend pool details.
+// @Description The subnet of a backend pool is fixed at creation, the targets and the health check replace the current ones.
+// @ID put-load-balancer-backend-pool
+// @Param projectId path string true "Project ID"
+// @Param loadBalancerId path string true "Load Balancer ID"
+// @Param id path string true "Backend Pool ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param loadBalancerBackendPoolUpdate body FlattenedUpdateLoadBalancerBackendPoolRequestDto true "Backend pool update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedLoadBalancerBackendPoolResponseDto "Backend pool details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/backendPools/{id} [put]
+func PutLoadBalancerBackendPool(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateLoadBalancerBackendPoolRequestDto, LoadBalancerBackendPoolUpdateDto, LoadBalancerBackendPoolResponseDto](opts, LoadBalancerBackendPool,
+		func(req FlattenedUpdateLoadBalancerBackendPoolRequestDto) LoadBalancerBackendPoolUpdateDto {
+			return LoadBalancerBackendPoolUpdateDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListLoadBalancerBackendPools
+// @Summary List backend pools on Aruba Cloud
+// @Description List backend pools on Aruba Cloud using the provided project and load balancer details.
+// @ID list-load-balancer-backend-pools
+// @Param projectId path string true "Project ID"
+// @Param loadBalancerId path string true "Load Balancer ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedLoadBalancerBackendPoolListResponseDto "A list of backend pools"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/backendPools [get]
+func ListLoadBalancerBackendPools(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[LoadBalancerBackendPoolResponseDto, FlattenedLoadBalancerBackendPoolResponseDto](opts, LoadBalancerBackendPool)
+}
+
+// DeleteLoadBalancerBackendPool
+// @Summary Delete a backend pool on Aruba Cloud
+// @Description Delete a backend pool on Aruba Cloud using the provided project, load balancer and backend pool details.
+// @Description Deleting a backend pool that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-load-balancer-backend-pool
+// @Param projectId path string true "Project ID"
+// @Param loadBalancerId path string true "Load Balancer ID"
+// @Param id path string true "Backend Pool ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/backendPools/{id} [delete]
+func DeleteLoadBalancerBackendPool(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, LoadBalancerBackendPool)
+}
+
+// Balancing algorithms of a backend pool
+const (
+	AlgorithmRoundRobin       = "RoundRobin"
+	AlgorithmLeastConnections = "LeastConnections"
+	AlgorithmSourceIp         = "SourceIp"
+)
+
+// Validate checks the subnet, the targets and the health check of the backend pool before it is sent to Aruba Cloud
+func (req FlattenedCreateLoadBalancerBackendPoolRequestDto) Validate() error {
+	props := req.Properties
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if props.Subnet == nil || props.Subnet.URI == "" {
+		return errors.New("properties.subnet.uri is required")
+	}
+	return validateBackendPool(props.Algorithm, props.Targets, props.HealthCheck)
+}
+
+// Validate checks the targets and the health check of the backend pool before they are sent to Aruba Cloud
+func (req FlattenedUpdateLoadBalancerBackendPoolRequestDto) Validate() error {
+	if req.Properties == nil {
+		return nil
+	}
+	return validateBackendPool(req.Properties.Algorithm, req.Properties.Targets, req.Properties.HealthCheck)
+}
+
+func validateBackendPool(algorithm string, targets []TargetDto, healthCheck *HealthCheckDto) error {
+	if algorithm != "" {
+		if err := validation.OneOf("properties.algorithm", algorithm, AlgorithmRoundRobin, AlgorithmLeastConnections, AlgorithmSourceIp); err != nil {
+			return err
+		}
+	}
+	for i, target := range targets {
+		if target.CloudServer == nil || target.CloudServer.URI == "" {
+			return fmt.Errorf("properties.targets[%d].cloudServer.uri is required", i)
+		}
+		if _, err := netip.ParseAddr(target.Address); err != nil {
+			return fmt.Errorf("properties.targets[%d].address must be the IP address of the cloud server in the subnet, got '%s'", i, target.Address)
+		}
+		if err := validation.Port(fmt.Sprintf("properties.targets[%d].port", i), target.Port); err != nil {
+			return err
+		}
+	}
+	if healthCheck != nil {
+		return validateHealthCheck(healthCheck)
+	}
+	return nil
+}
+
+// validateHealthCheck checks that HTTP health checks have a path and that a probe ends before the next one
+func validateHealthCheck(hc *HealthCheckDto) error {
+	if err := validation.OneOf("properties.healthCheck.protocol", hc.Protocol, ProtocolTCP, ProtocolHTTP, ProtocolHTTPS); err != nil {
+		return err
+	}
+	if hc.Port != 0 {
+		if err := validation.Port("properties.healthCheck.port", hc.Port); err != nil {
+			return err
+		}
+	}
+	if hc.Protocol != ProtocolTCP && !strings.HasPrefix(hc.Path, "/") {
+		return fmt.Errorf("properties.healthCheck.path must start with '/' for %s health checks, got '%s'", hc.Protocol, hc.Path)
+	}
+	if hc.IntervalSeconds < 0 || hc.TimeoutSeconds < 0 || hc.HealthyThreshold < 0 || hc.UnhealthyThreshold < 0 {
+		return errors.New("properties.healthCheck intervals, timeouts and thresholds must not be negative")
+	}
+	if hc.IntervalSeconds > 0 && hc.TimeoutSeconds >= hc.IntervalSeconds {
+		return fmt.Errorf("properties.healthCheck.timeoutSeconds (%d) must be lower than properties.healthCheck.intervalSeconds (%d)", hc.TimeoutSeconds, hc.IntervalSeconds)
+	}
+	return nil
+}
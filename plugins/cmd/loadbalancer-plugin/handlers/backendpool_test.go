@@ -0,0 +1,90 @@
+package loadbalancer
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// TestLoadBalancerBackendPoolHandlers tests that the targets and the health check of the valid backend pools are sent
+// to Aruba Cloud, and that the subnet fixed at creation is left out of the updates
+func TestLoadBalancerBackendPoolHandlers(t *testing.T) {
+	const subnet = `"subnet":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/subnets/s1"}`
+	const targets = `"targets":[{"cloudServer":{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/cs1"},"address":"10.0.0.10","port":8080},` +
+		`{"cloudServer":{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/cs2"},"address":"10.0.0.11","port":8080}]`
+	const healthCheck = `"healthCheck":{"protocol":"HTTP","port":8081,"path":"/healthz","intervalSeconds":10,"timeoutSeconds":5,"healthyThreshold":2,"unhealthyThreshold":3}`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedBody   string
+		expectedStatus int
+	}{
+		{
+			name:           "create",
+			method:         http.MethodPost,
+			target:         backendPoolsURI,
+			body:           `{"name":"web","properties":{` + subnet + `,"algorithm":"RoundRobin",` + targets + `,` + healthCheck + `}}`,
+			expectedBody:   `{"metadata":{"name":"web"},"properties":{` + subnet + `,"algorithm":"RoundRobin",` + targets + `,` + healthCheck + `}}`,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "create with a tcp health check on the target ports",
+			method:         http.MethodPost,
+			target:         backendPoolsURI,
+			body:           `{"name":"web","properties":{` + subnet + `,` + targets + `,"healthCheck":{"protocol":"TCP"}}}`,
+			expectedBody:   `{"metadata":{"name":"web"},"properties":{` + subnet + `,` + targets + `,"healthCheck":{"protocol":"TCP"}}}`,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "update leaves out the subnet",
+			method:         http.MethodPut,
+			target:         backendPoolURI,
+			body:           `{"name":"web","properties":{` + subnet + `,"algorithm":"LeastConnections",` + targets + `,` + healthCheck + `}}`,
+			expectedBody:   `{"metadata":{"name":"web"},"properties":{"algorithm":"LeastConnections",` + targets + `,` + healthCheck + `}}`,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.expectedStatus)
+				w.Write([]byte(`{"metadata":{"id":"bp1","name":"web"}}`))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			expectedCall := handlertest.Call{Method: tc.method, URI: tc.target, Body: tc.expectedBody}
+			if len(*calls) != 1 || (*calls)[0] != expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+// TestLoadBalancerBackendPoolHandlers_Validation tests that the backend pools with invalid targets or health check are rejected before calling Aruba Cloud
+func TestLoadBalancerBackendPoolHandlers_Validation(t *testing.T) {
+	const subnet = `"subnet":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/subnets/s1"}`
+	const cloudServer = `"cloudServer":{"uri":"/projects/p1/providers/Aruba.Compute/cloudServers/cs1"}`
+	const target = `"targets":[{` + cloudServer + `,"address":"10.0.0.10","port":8080}]`
+
+	runValidationTestCases(t, []validationTestCase{
+		{name: "no subnet", method: http.MethodPost, target: backendPoolsURI, body: `{"properties":{` + target + `}}`, expectedDetail: "properties.subnet.uri is required"},
+		{name: "unknown algorithm", method: http.MethodPost, target: backendPoolsURI, body: `{"properties":{` + subnet + `,"algorithm":"Random",` + target + `}}`, expectedDetail: "properties.algorithm must be one of RoundRobin, LeastConnections, SourceIp, got 'Random'"},
+		{name: "target without cloud server", method: http.MethodPost, target: backendPoolsURI, body: `{"properties":{` + subnet + `,"targets":[{"address":"10.0.0.10","port":8080}]}}`, expectedDetail: "properties.targets[0].cloudServer.uri is required"},
+		{name: "target with a host name", method: http.MethodPost, target: backendPoolsURI, body: `{"properties":{` + subnet + `,"targets":[{` + cloudServer + `,"address":"web-1","port":8080}]}}`, expectedDetail: "properties.targets[0].address must be the IP address of the cloud server in the subnet, got 'web-1'"},
+		{name: "target without port", method: http.MethodPost, target: backendPoolsURI, body: `{"properties":{` + subnet + `,"targets":[{` + cloudServer + `,"address":"10.0.0.10","port":8080},{` + cloudServer + `,"address":"10.0.0.11"}]}}`, expectedDetail: "properties.targets[1].port must be a port between 1 and 65535, got 0"},
+		{name: "unknown health check protocol", method: http.MethodPost, target: backendPoolsURI, body: `{"properties":{` + subnet + `,` + target + `,"healthCheck":{"protocol":"UDP"}}}`, expectedDetail: "properties.healthCheck.protocol must be one of TCP, HTTP, HTTPS, got 'UDP'"},
+		{name: "health check port out of range", method: http.MethodPost, target: backendPoolsURI, body: `{"properties":{` + subnet + `,` + target + `,"healthCheck":{"protocol":"TCP","port":70000}}}`, expectedDetail: "properties.healthCheck.port must be a port between 1 and 65535, got 70000"},
+		{name: "http health check without path", method: http.MethodPost, target: backendPoolsURI, body: `{"properties":{` + subnet + `,` + target + `,"healthCheck":{"protocol":"HTTP"}}}`, expectedDetail: "properties.healthCheck.path must start with '/' for HTTP health checks, got ''"},
+		{name: "negative threshold", method: http.MethodPut, target: backendPoolURI, body: `{"properties":{` + target + `,"healthCheck":{"protocol":"TCP","healthyThreshold":-1}}}`, expectedDetail: "properties.healthCheck intervals, timeouts and thresholds must not be negative"},
+		{name: "timeout not lower than interval on update", method: http.MethodPut, target: backendPoolURI, body: `{"properties":{` + target + `,"healthCheck":{"protocol":"HTTPS","path":"/healthz","intervalSeconds":5,"timeoutSeconds":5}}}`, expectedDetail: "properties.healthCheck.timeoutSeconds (5) must be lower than properties.healthCheck.intervalSeconds (5)"},
+	})
+}
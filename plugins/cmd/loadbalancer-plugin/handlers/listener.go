@@ -0,0 +1,191 @@
+package loadbalancer
+
+import (
+	"errors"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// LoadBalancerListener is the Aruba Cloud listener resource of a load balancer, receiving the traffic on a protocol and port
+var LoadBalancerListener = handlers.Resource{
+	Name:   "listener",
+	Plural: "listeners",
+	Path:   "/projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/listeners",
+	PathParams: []handlers.Param{
+		handlers.ProjectIDParam,
+		{Name: "loadBalancerId", Label: "Load Balancer ID"},
+	},
+	IDParam:       handlers.Param{Name: "id", Label: "Listener ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetLoadBalancerListener
+// @Summary Get a listener from Aruba Cloud
+// @Description Get a listener from Aruba Cloud using the provided project, load balancer and listener details.
+// @ID get-load-balancer-listener
+// @Param projectId path string true "Project ID"
+// @Param loadBalancerId path string true "Load Balancer ID"
+// @Param id path string true "Listener ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedLoadBalancerListenerResponseDto "Listener details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/listeners/{id} [get]
+func GetLoadBalancerListener(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[LoadBalancerListenerResponseDto](opts, LoadBalancerListener)
+}
+
+// PostLoadBalancerListener
+// @Summary Create a new listener on Aruba Cloud
+// @Description Create a new listener on Aruba Cloud using the provided project and load balancer details.
+// @ID post-load-balancer-listener
+// @Param projectId path string true "Project ID"
+// @Param loadBalancerId path string true "Load Balancer ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param loadBalancerListenerCreate body FlattenedCreateLoadBalancerListenerRequestDto true "Listener creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedLoadBalancerListenerResponseDto "Listener details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/listeners [post]
+func PostLoadBalancerListener(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateLoadBalancerListenerRequestDto, LoadBalancerListenerDto, LoadBalancerListenerResponseDto](opts, LoadBalancerListener,
+		func(req FlattenedCreateLoadBalancerListenerRequestDto) LoadBalancerListenerDto {
+			return LoadBalancerListenerDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutLoadBalancerListener
+// @Summary Update a listener on Aruba Cloud
+// @Description Update a listener on Aruba Cloud using the provided project, load balancer and listener details.
+// @ID put-load-balancer-listener
+// @Param projectId path string true "Project ID"
+// @Param loadBalancerId path string true "Load Balancer ID"
+// @Param id path string true "Listener ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param loadBalancerListenerUpdate body FlattenedUpdateLoadBalancerListenerRequestDto true "Listener update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedLoadBalancerListenerResponseDto "Listener details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/listeners/{id} [put]
+func PutLoadBalancerListener(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateLoadBalancerListenerRequestDto, LoadBalancerListenerDto, LoadBalancerListenerResponseDto](opts, LoadBalancerListener,
+		func(req FlattenedUpdateLoadBalancerListenerRequestDto) LoadBalancerListenerDto {
+			return LoadBalancerListenerDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListLoadBalancerListeners
+// @Summary List listeners on Aruba Cloud
+// @Description List listeners on Aruba Cloud using the provided project and load balancer details.
+// @ID list-load-balancer-listeners
+// @Param projectId path string true "Project ID"
+// @Param loadBalancerId path string true "Load Balancer ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedLoadBalancerListenerListResponseDto "A list of listeners"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/listeners [get]
+func ListLoadBalancerListeners(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[LoadBalancerListenerResponseDto, FlattenedLoadBalancerListenerResponseDto](opts, LoadBalancerListener)
+}
+
+// DeleteLoadBalancerListener
+// @Summary Delete a listener on Aruba Cloud
+// @Description Delete a listener on Aruba Cloud using the provided project, load balancer and listener details.
+// @Description Deleting a listener that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-load-balancer-listener
+// @Param projectId path string true "Project ID"
+// @Param loadBalancerId path string true "Load Balancer ID"
+// @Param id path string true "Listener ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/listeners/{id} [delete]
+func DeleteLoadBalancerListener(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, LoadBalancerListener)
+}
+
+// Protocols accepted by the listeners of a load balancer
+const (
+	ProtocolTCP   = "TCP"
+	ProtocolUDP   = "UDP"
+	ProtocolHTTP  = "HTTP"
+	ProtocolHTTPS = "HTTPS"
+)
+
+// Validate checks the protocol, the port and the backend pool of the listener before it is sent to Aruba Cloud
+func (req FlattenedCreateLoadBalancerListenerRequestDto) Validate() error {
+	return validateListener(req.Properties)
+}
+
+// Validate checks the protocol, the port and the backend pool of the listener before it is sent to Aruba Cloud
+func (req FlattenedUpdateLoadBalancerListenerRequestDto) Validate() error {
+	return validateListener(req.Properties)
+}
+
+func validateListener(props *ListenerPropertiesDto) error {
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if err := validation.OneOf("properties.protocol", props.Protocol, ProtocolTCP, ProtocolUDP, ProtocolHTTP, ProtocolHTTPS); err != nil {
+		return err
+	}
+	if err := validation.Port("properties.port", props.Port); err != nil {
+		return err
+	}
+	if props.BackendPool == nil || props.BackendPool.URI == "" {
+		return errors.New("properties.backendPool.uri is required")
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package loadbalancer
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+const (
+	listenersURI    = "/projects/p1/providers/Aruba.Network/loadBalancers/lb1/listeners?api-version=1.0"
+	listenerURI     = "/projects/p1/providers/Aruba.Network/loadBalancers/lb1/listeners/l1?api-version=1.0"
+	backendPoolsURI = "/projects/p1/providers/Aruba.Network/loadBalancers/lb1/backendPools?api-version=1.0"
+	backendPoolURI  = "/projects/p1/providers/Aruba.Network/loadBalancers/lb1/backendPools/bp1?api-version=1.0"
+)
+
+// newTestMux serves the listener and backend pool handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	mux := http.NewServeMux()
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/listeners", PostLoadBalancerListener(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/listeners/{id}", PutLoadBalancerListener(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/backendPools", PostLoadBalancerBackendPool(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/backendPools/{id}", PutLoadBalancerBackendPool(opts))
+	return mux, calls
+}
+
+// validationTestCase is a request expected to be rejected with expectedDetail before calling Aruba Cloud
+type validationTestCase struct {
+	name           string
+	method         string
+	target         string
+	body           string
+	expectedDetail string
+}
+
+// runValidationTestCases serves the test cases, checking that they are rejected with a 400 problem and no upstream call
+func runValidationTestCases(t *testing.T, testCases []validationTestCase) {
+	t.Helper()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 0 {
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			}
+			if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+				t.Fatalf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+			}
+			var problem handlers.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
+
+// TestLoadBalancerListenerHandlers tests that the valid listeners are sent to Aruba Cloud unchanged
+func TestLoadBalancerListenerHandlers(t *testing.T) {
+	const backendPool = `"backendPool":{"uri":"/projects/p1/providers/Aruba.Network/loadBalancers/lb1/backendPools/bp1"}`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		properties     string
+		expectedStatus int
+	}{
+		{name: "tcp", method: http.MethodPost, target: listenersURI, properties: `{"protocol":"TCP","port":5432,` + backendPool + `}`, expectedStatus: http.StatusCreated},
+		{name: "udp", method: http.MethodPost, target: listenersURI, properties: `{"protocol":"UDP","port":53,` + backendPool + `}`, expectedStatus: http.StatusCreated},
+		{name: "http", method: http.MethodPost, target: listenersURI, properties: `{"protocol":"HTTP","port":80,` + backendPool + `}`, expectedStatus: http.StatusCreated},
+		{name: "https on update", method: http.MethodPut, target: listenerURI, properties: `{"protocol":"HTTPS","port":65535,` + backendPool + `}`, expectedStatus: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.expectedStatus)
+				w.Write([]byte(`{"metadata":{"id":"l1","name":"web"}}`))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, `{"name":"web","properties":`+tc.properties+`}`)
+
+			expectedCall := handlertest.Call{Method: tc.method, URI: tc.target, Body: `{"metadata":{"name":"web"},"properties":` + tc.properties + `}`}
+			if len(*calls) != 1 || (*calls)[0] != expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+// TestLoadBalancerListenerHandlers_Validation tests that the listeners with an invalid protocol, port or backend pool are rejected before calling Aruba Cloud
+func TestLoadBalancerListenerHandlers_Validation(t *testing.T) {
+	const backendPool = `"backendPool":{"uri":"/projects/p1/providers/Aruba.Network/loadBalancers/lb1/backendPools/bp1"}`
+
+	runValidationTestCases(t, []validationTestCase{
+		{name: "no properties", method: http.MethodPost, target: listenersURI, body: `{"name":"web"}`, expectedDetail: "properties are required"},
+		{name: "unknown protocol", method: http.MethodPost, target: listenersURI, body: `{"properties":{"protocol":"QUIC","port":443,` + backendPool + `}}`, expectedDetail: "properties.protocol must be one of TCP, UDP, HTTP, HTTPS, got 'QUIC'"},
+		{name: "missing port", method: http.MethodPost, target: listenersURI, body: `{"properties":{"protocol":"HTTP",` + backendPool + `}}`, expectedDetail: "properties.port must be a port between 1 and 65535, got 0"},
+		{name: "port out of range on update", method: http.MethodPut, target: listenerURI, body: `{"properties":{"protocol":"HTTP","port":65536,` + backendPool + `}}`, expectedDetail: "properties.port must be a port between 1 and 65535, got 65536"},
+		{name: "no backend pool", method: http.MethodPost, target: listenersURI, body: `{"properties":{"protocol":"HTTP","port":80}}`, expectedDetail: "properties.backendPool.uri is required"},
+	})
+}
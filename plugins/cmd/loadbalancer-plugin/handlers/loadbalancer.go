@@ -0,0 +1,164 @@
+package loadbalancer
+
+import (
+	"errors"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+)
+
+// LoadBalancer is the Aruba Cloud load balancer resource
+var LoadBalancer = handlers.Resource{
+	Name:          "load balancer",
+	Plural:        "load balancers",
+	Path:          "/projects/{projectId}/providers/Aruba.Network/loadBalancers",
+	PathParams:    []handlers.Param{handlers.ProjectIDParam},
+	IDParam:       handlers.Param{Name: "id", Label: "Load Balancer ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetLoadBalancer
+// @Summary Get a load balancer from Aruba Cloud
+// @Description Get a load balancer from Aruba Cloud using the provided project and load balancer details.
+// @Description The state of the load balancer is reported in status.state, e.g. InCreation while it is being provisioned and Active once it can be used.
+// @ID get-load-balancer
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Load Balancer ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedLoadBalancerResponseDto "Load balancer details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers/{id} [get]
+func GetLoadBalancer(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[LoadBalancerResponseDto](opts, LoadBalancer)
+}
+
+// PostLoadBalancer
+// @Summary Create a new load balancer on Aruba Cloud
+// @Description Create a new load balancer on Aruba Cloud using the provided project details.
+// @ID post-load-balancer
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param loadBalancerCreate body FlattenedCreateLoadBalancerRequestDto true "Load balancer creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedLoadBalancerResponseDto "Load balancer details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers [post]
+func PostLoadBalancer(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateLoadBalancerRequestDto, LoadBalancerDto, LoadBalancerResponseDto](opts, LoadBalancer,
+		func(req FlattenedCreateLoadBalancerRequestDto) LoadBalancerDto {
+			return LoadBalancerDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutLoadBalancer
+// @Summary Update a load balancer on Aruba Cloud
+// @Description Update a load balancer on Aruba Cloud using the provided project and load balancer details.
+// @Description Only the name, location and tags of a load balancer can be updated.
+// @ID put-load-balancer
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Load Balancer ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param loadBalancerUpdate body FlattenedUpdateLoadBalancerRequestDto true "Load balancer update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedLoadBalancerResponseDto "Load balancer details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers/{id} [put]
+func PutLoadBalancer(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateLoadBalancerRequestDto, LoadBalancerUpdateDto, LoadBalancerResponseDto](opts, LoadBalancer,
+		func(req FlattenedUpdateLoadBalancerRequestDto) LoadBalancerUpdateDto {
+			return LoadBalancerUpdateDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+			}
+		})
+}
+
+// ListLoadBalancers
+// @Summary List load balancers on Aruba Cloud
+// @Description List load balancers on Aruba Cloud using the provided project details.
+// @ID list-load-balancers
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedLoadBalancerListResponseDto "A list of load balancers"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers [get]
+func ListLoadBalancers(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[LoadBalancerResponseDto, FlattenedLoadBalancerResponseDto](opts, LoadBalancer)
+}
+
+// DeleteLoadBalancer
+// @Summary Delete a load balancer on Aruba Cloud
+// @Description Delete a load balancer on Aruba Cloud using the provided project and load balancer details.
+// @Description Deleting a load balancer that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-load-balancer
+// @Param projectId path string true "Project ID"
+// @Param id path string true "Load Balancer ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/loadBalancers/{id} [delete]
+func DeleteLoadBalancer(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, LoadBalancer)
+}
+
+// Validate checks the network of the load balancer before it is sent to Aruba Cloud
+func (req FlattenedCreateLoadBalancerRequestDto) Validate() error {
+	props := req.Properties
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if props.Vpc == nil || props.Vpc.URI == "" {
+		return errors.New("properties.vpc.uri is required")
+	}
+	if props.Subnet == nil || props.Subnet.URI == "" {
+		return errors.New("properties.subnet.uri is required")
+	}
+	return nil
+}
@@ -0,0 +1,509 @@
+package loadbalancer
+
+type LoadBalancerDto struct {
+	Metadata   *MetadataDto               `json:"metadata,omitempty"`
+	Properties *LoadBalancerPropertiesDto `json:"properties,omitempty"`
+}
+
+// LoadBalancerUpdateDto only carries the metadata, the properties of a load balancer cannot be updated
+type LoadBalancerUpdateDto struct {
+	Metadata *MetadataDto `json:"metadata,omitempty"`
+}
+
+type LoadBalancerListenerDto struct {
+	Metadata   *MetadataDto           `json:"metadata,omitempty"`
+	Properties *ListenerPropertiesDto `json:"properties,omitempty"`
+}
+
+type LoadBalancerBackendPoolDto struct {
+	Metadata   *MetadataDto              `json:"metadata,omitempty"`
+	Properties *BackendPoolPropertiesDto `json:"properties,omitempty"`
+}
+
+type LoadBalancerBackendPoolUpdateDto struct {
+	Metadata   *MetadataDto                    `json:"metadata,omitempty"`
+	Properties *BackendPoolUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+type MetadataDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+}
+
+type LocationDto struct {
+	// Value is the region where the resource will be located.
+	// Available regions at present: ITBG-Bergamo.
+	Value string `json:"value,omitempty"`
+}
+
+type LoadBalancerPropertiesDto struct {
+	// Vpc is the VPC in which the load balancer is created.
+	Vpc *ReferenceDto `json:"vpc,omitempty"`
+	// Subnet is the subnet of the VPC the load balancer is attached to.
+	Subnet *ReferenceDto `json:"subnet,omitempty"`
+	// ElasticIp is the Elastic IP exposing the load balancer. The load balancer is internal without it.
+	ElasticIp *ReferenceDto `json:"elasticIp,omitempty"`
+}
+
+type ListenerPropertiesDto struct {
+	// Protocol is the protocol of the traffic received by the listener.
+	// Allowed values: TCP, UDP, HTTP, HTTPS.
+	Protocol string `json:"protocol,omitempty"`
+	// Port is the port the listener receives the traffic on.
+	Port int32 `json:"port,omitempty"`
+	// BackendPool is the backend pool the traffic is forwarded to,
+	// e.g. /projects/<PROJECT_ID>/providers/Aruba.Network/loadBalancers/<LOAD_BALANCER_ID>/backendPools/<BACKEND_POOL_ID>.
+	BackendPool *ReferenceDto `json:"backendPool,omitempty"`
+}
+
+type BackendPoolPropertiesDto struct {
+	// Subnet is the subnet of the cloud servers of the pool.
+	Subnet *ReferenceDto `json:"subnet,omitempty"`
+	// Algorithm is the balancing algorithm of the pool.
+	// Allowed values: RoundRobin, LeastConnections, SourceIp.
+	Algorithm string `json:"algorithm,omitempty"`
+	// Targets are the cloud servers the traffic is balanced on.
+	Targets []TargetDto `json:"targets,omitempty"`
+	// HealthCheck is the check excluding the unhealthy targets from the balancing.
+	HealthCheck *HealthCheckDto `json:"healthCheck,omitempty"`
+}
+
+type BackendPoolUpdatePropertiesDto struct {
+	// Algorithm is the balancing algorithm of the pool.
+	// Allowed values: RoundRobin, LeastConnections, SourceIp.
+	Algorithm string `json:"algorithm,omitempty"`
+	// Targets are the cloud servers the traffic is balanced on.
+	Targets []TargetDto `json:"targets,omitempty"`
+	// HealthCheck is the check excluding the unhealthy targets from the balancing.
+	HealthCheck *HealthCheckDto `json:"healthCheck,omitempty"`
+}
+
+type TargetDto struct {
+	// CloudServer is the cloud server receiving the traffic.
+	CloudServer *ReferenceDto `json:"cloudServer,omitempty"`
+	// Address is the IP address of the cloud server in the subnet of the pool.
+	Address string `json:"address,omitempty"`
+	// Port is the port of the cloud server receiving the traffic.
+	Port int32 `json:"port,omitempty"`
+}
+
+type HealthCheckDto struct {
+	// Protocol is the protocol of the probes.
+	// Allowed values: TCP, HTTP, HTTPS.
+	Protocol string `json:"protocol,omitempty"`
+	// Port is the port probed on the targets, the port of each target if empty.
+	Port int32 `json:"port,omitempty"`
+	// Path is the path requested by HTTP and HTTPS probes, e.g. /healthz.
+	Path string `json:"path,omitempty"`
+	// IntervalSeconds is the time between two probes.
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty"`
+	// TimeoutSeconds is the time after which a probe fails, lower than IntervalSeconds.
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+	// HealthyThreshold is the number of successful probes after which a target is healthy.
+	HealthyThreshold int32 `json:"healthyThreshold,omitempty"`
+	// UnhealthyThreshold is the number of failed probes after which a target is unhealthy.
+	UnhealthyThreshold int32 `json:"unhealthyThreshold,omitempty"`
+}
+
+type ReferenceDto struct {
+	// URI is the URI of the referenced resource,
+	// e.g. /projects/<PROJECT_ID>/providers/Aruba.Compute/cloudServers/<CLOUD_SERVER_ID>.
+	URI string `json:"uri,omitempty"`
+}
+
+type LoadBalancerResponseDto struct {
+	Metadata   *MetadataResponseDto               `json:"metadata,omitempty"`
+	Status     *StatusResponseDto                 `json:"status,omitempty"`
+	Properties *LoadBalancerPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type LoadBalancerListenerResponseDto struct {
+	Metadata   *MetadataResponseDto           `json:"metadata,omitempty"`
+	Status     *StatusResponseDto             `json:"status,omitempty"`
+	Properties *ListenerPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type LoadBalancerBackendPoolResponseDto struct {
+	Metadata   *MetadataResponseDto              `json:"metadata,omitempty"`
+	Status     *StatusResponseDto                `json:"status,omitempty"`
+	Properties *BackendPoolPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type MetadataResponseDto struct {
+	ID           string               `json:"id,omitempty"`
+	URI          string               `json:"uri,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	Location     *LocationResponseDto `json:"location,omitempty"`
+	Project      *ProjectResponseDto  `json:"project,omitempty"`
+	Tags         []string             `json:"tags,omitempty"`
+	Category     *CategoryResponseDto `json:"category,omitempty"`
+	CreationDate string               `json:"creationDate,omitempty"`
+	CreatedBy    string               `json:"createdBy,omitempty"`
+	UpdateDate   string               `json:"updateDate,omitempty"`
+	UpdatedBy    string               `json:"updatedBy,omitempty"`
+	Version      string               `json:"version,omitempty"`
+	CreatedUser  string               `json:"createdUser,omitempty"`
+	UpdatedUser  string               `json:"updatedUser,omitempty"`
+}
+
+type LocationResponseDto struct {
+	// Code is the code of the region.
+	Code string `json:"code,omitempty"`
+	// Country is the country of the region.
+	Country string `json:"country,omitempty"`
+	// City is the city of the region.
+	City string `json:"city,omitempty"`
+	// Name is the name of the region.
+	Name string `json:"name,omitempty"`
+	// Value is the value of the region.
+	Value string `json:"value,omitempty"`
+}
+
+type ProjectResponseDto struct {
+	// ID is the unique identifier of the project.
+	ID string `json:"id,omitempty"`
+}
+
+type CategoryResponseDto struct {
+	// Name is the name of the category.
+	Name string `json:"name,omitempty"`
+	// Provider is the provider of the category.
+	Provider string `json:"provider,omitempty"`
+	// Typology is the typology of the category.
+	Typology *TypologyResponseDto `json:"typology,omitempty"`
+}
+
+type TypologyResponseDto struct {
+	// ID is the unique identifier of the typology.
+	ID string `json:"id,omitempty"`
+	// Name is the name of the typology.
+	Name string `json:"name,omitempty"`
+}
+
+type StatusResponseDto struct {
+	// State is the state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the status.
+	CreationDate string `json:"creationDate,omitempty"`
+	// DisableStatusInfo contains the information about the disabled status of the resource.
+	DisableStatusInfo *DisableStatusInfoResponseDto `json:"disableStatusInfo,omitempty"`
+	// FailureReason is the reason of the failure, if any.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+type DisableStatusInfoResponseDto struct {
+	// IsDisabled indicates if the resource is disabled.
+	IsDisabled bool `json:"isDisabled,omitempty"`
+	// Reasons is a list of reasons for the disabled status.
+	Reasons []string `json:"reasons,omitempty"`
+	// PreviousStatus is the previous status of the resource.
+	PreviousStatus *PreviousStatusResponseDto `json:"previousStatus,omitempty"`
+}
+
+type PreviousStatusResponseDto struct {
+	// State is the previous state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the previous status.
+	CreationDate string `json:"creationDate,omitempty"`
+}
+
+type LoadBalancerPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the load balancer, e.g. its listeners and backend pools.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Vpc is the VPC of the load balancer.
+	Vpc *ReferenceDto `json:"vpc,omitempty"`
+	// Subnet is the subnet the load balancer is attached to.
+	Subnet *ReferenceDto `json:"subnet,omitempty"`
+	// ElasticIp is the Elastic IP exposing the load balancer, if any.
+	ElasticIp *ReferenceDto `json:"elasticIp,omitempty"`
+	// Address is the IP address of the load balancer in its subnet.
+	Address string `json:"address,omitempty"`
+}
+
+type ListenerPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the listener, e.g. its backend pool.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Protocol is the protocol of the traffic received by the listener.
+	Protocol string `json:"protocol,omitempty"`
+	// Port is the port the listener receives the traffic on.
+	Port int32 `json:"port,omitempty"`
+	// BackendPool is the backend pool the traffic is forwarded to.
+	BackendPool *ReferenceDto `json:"backendPool,omitempty"`
+}
+
+type BackendPoolPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the backend pool, e.g. its cloud servers.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Subnet is the subnet of the cloud servers of the pool.
+	Subnet *ReferenceDto `json:"subnet,omitempty"`
+	// Algorithm is the balancing algorithm of the pool.
+	Algorithm string `json:"algorithm,omitempty"`
+	// Targets are the cloud servers the traffic is balanced on, with their health.
+	Targets []TargetResponseDto `json:"targets,omitempty"`
+	// HealthCheck is the check excluding the unhealthy targets from the balancing.
+	HealthCheck *HealthCheckDto `json:"healthCheck,omitempty"`
+}
+
+type TargetResponseDto struct {
+	// CloudServer is the cloud server receiving the traffic.
+	CloudServer *ReferenceDto `json:"cloudServer,omitempty"`
+	// Address is the IP address of the cloud server in the subnet of the pool.
+	Address string `json:"address,omitempty"`
+	// Port is the port of the cloud server receiving the traffic.
+	Port int32 `json:"port,omitempty"`
+	// Health is the result of the health check of the target, e.g. Healthy or Unhealthy.
+	Health string `json:"health,omitempty"`
+}
+
+type LinkedResourceResponseDto struct {
+	// URI is the URI of the linked resource.
+	URI string `json:"uri,omitempty"`
+	// StrictCorrelation indicates if the correlation is strict.
+	StrictCorrelation bool `json:"strictCorrelation,omitempty"`
+}
+
+// --------------------------------------------------------------------------
+// Flattened types
+// --------------------------------------------------------------------------
+
+// FlattenedCreateLoadBalancerRequestDto is the flattened request body for creating a load balancer.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateLoadBalancerRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the load balancer.
+	Properties *LoadBalancerPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateLoadBalancerRequestDto is the flattened request body for updating a load balancer.
+// The fields from MetadataDto are at the root level, the properties cannot be updated.
+type FlattenedUpdateLoadBalancerRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// FlattenedLoadBalancerResponseDto is the flattened response body for a single load balancer.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedLoadBalancerResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the load balancer.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the load balancer.
+	Properties *LoadBalancerPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedLoadBalancerListResponseDto struct {
+	// Total is the total number of load balancers.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened load balancers.
+	Values []FlattenedLoadBalancerResponseDto `json:"values,omitempty"`
+}
+
+// FlattenedCreateLoadBalancerListenerRequestDto is the flattened request body for creating a listener.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateLoadBalancerListenerRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the listener.
+	Properties *ListenerPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateLoadBalancerListenerRequestDto is the flattened request body for updating a listener.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateLoadBalancerListenerRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the listener.
+	Properties *ListenerPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedLoadBalancerListenerResponseDto is the flattened response body for a single listener.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedLoadBalancerListenerResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the listener.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the listener.
+	Properties *ListenerPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedLoadBalancerListenerListResponseDto struct {
+	// Total is the total number of listeners.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened listeners.
+	Values []FlattenedLoadBalancerListenerResponseDto `json:"values,omitempty"`
+}
+
+// FlattenedCreateLoadBalancerBackendPoolRequestDto is the flattened request body for creating a backend pool.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateLoadBalancerBackendPoolRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the backend pool.
+	Properties *BackendPoolPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateLoadBalancerBackendPoolRequestDto is the flattened request body for updating a backend pool.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateLoadBalancerBackendPoolRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the backend pool.
+	Properties *BackendPoolUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedLoadBalancerBackendPoolResponseDto is the flattened response body for a single backend pool.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedLoadBalancerBackendPoolResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the backend pool.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the backend pool.
+	Properties *BackendPoolPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedLoadBalancerBackendPoolListResponseDto struct {
+	// Total is the total number of backend pools.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened backend pools.
+	Values []FlattenedLoadBalancerBackendPoolResponseDto `json:"values,omitempty"`
+}
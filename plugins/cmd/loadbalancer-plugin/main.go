@@ -0,0 +1,63 @@
+package main
+
+import (
+	loadbalancer "github.com/krateoplatformops/arubacloud-provider-kog/loadbalancer-plugin/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/health"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/server"
+	httpSwagger "github.com/swaggo/http-swagger"
+)
+
+// @title           Aruba Cloud Load Balancer Plugin API for Krateo Operator Generator (KOG)
+// @version         1.0
+// @description     Simple wrapper around Aruba Cloud API to provide consistency of API response for Krateo Operator Generator (KOG)
+// @termsOfService  http://swagger.io/terms/
+// @contact.name    Krateo Support
+// @contact.url     https://krateo.io
+// @contact.email   contact@krateoplatformops.io
+// @license.name    Apache 2.0
+// @license.url     http://www.apache.org/licenses/LICENSE-2.0.html
+// @host            localhost:8080
+// @BasePath        /
+// @schemes         http
+func main() {
+	srv := server.New()
+
+	opts := handlers.HandlerOptions{
+		Log:      srv.Logger(),
+		Client:   srv.HTTPClient(),
+		BaseURL:  srv.BaseURL(),
+		Auth:     srv.TokenProvider(),
+		Timeouts: srv.UpstreamTimeouts(),
+	}
+
+	// Load balancer
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Network/loadBalancers", loadbalancer.PostLoadBalancer(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Network/loadBalancers", loadbalancer.ListLoadBalancers(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Network/loadBalancers/{id}", loadbalancer.GetLoadBalancer(opts))
+	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Network/loadBalancers/{id}", loadbalancer.PutLoadBalancer(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Network/loadBalancers/{id}", loadbalancer.DeleteLoadBalancer(opts))
+
+	// Listener
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/listeners", loadbalancer.PostLoadBalancerListener(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/listeners", loadbalancer.ListLoadBalancerListeners(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/listeners/{id}", loadbalancer.GetLoadBalancerListener(opts))
+	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/listeners/{id}", loadbalancer.PutLoadBalancerListener(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/listeners/{id}", loadbalancer.DeleteLoadBalancerListener(opts))
+
+	// Backend pool
+	srv.Mux().Handle("POST /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/backendPools", loadbalancer.PostLoadBalancerBackendPool(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/backendPools", loadbalancer.ListLoadBalancerBackendPools(opts))
+	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/backendPools/{id}", loadbalancer.GetLoadBalancerBackendPool(opts))
+	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/backendPools/{id}", loadbalancer.PutLoadBalancerBackendPool(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Network/loadBalancers/{loadBalancerId}/backendPools/{id}", loadbalancer.DeleteLoadBalancerBackendPool(opts))
+
+	// Swagger UI
+	srv.Mux().Handle("/swagger/", httpSwagger.WrapHandler)
+
+	// Kubernetes health check endpoints
+	srv.Mux().HandleFunc("GET /healthz", health.LivenessHandler(srv.Healthy()))
+	srv.Mux().HandleFunc("GET /readyz", srv.ReadinessHandler())
+
+	srv.Run()
+}
@@ -0,0 +1,154 @@
+package securitygroup
+
+import (
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+)
+
+// SecurityGroup is the Aruba Cloud security group resource, scoped to a VPC
+var SecurityGroup = handlers.Resource{
+	Name:   "security group",
+	Plural: "security groups",
+	Path:   "/projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/securityGroups",
+	PathParams: []handlers.Param{
+		handlers.ProjectIDParam,
+		{Name: "vpcId", Label: "VPC ID"},
+	},
+	IDParam:       handlers.Param{Name: "id", Label: "Security Group ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetSecurityGroup
+// @Summary Get a security group from Aruba Cloud
+// @Description Get a security group from Aruba Cloud using the provided project, VPC and security group details.
+// @ID get-security-group
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param id path string true "Security Group ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedSecurityGroupResponseDto "Security group details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/securityGroups/{id} [get]
+func GetSecurityGroup(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[SecurityGroupResponseDto](opts, SecurityGroup)
+}
+
+// PostSecurityGroup
+// @Summary Create a new security group on Aruba Cloud
+// @Description Create a new security group on Aruba Cloud using the provided project and VPC details.
+// @ID post-security-group
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param securityGroupCreate body FlattenedCreateSecurityGroupRequestDto true "Security group creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedSecurityGroupResponseDto "Security group details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/securityGroups [post]
+func PostSecurityGroup(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateSecurityGroupRequestDto, SecurityGroupDto, SecurityGroupResponseDto](opts, SecurityGroup,
+		func(req FlattenedCreateSecurityGroupRequestDto) SecurityGroupDto {
+			return SecurityGroupDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutSecurityGroup
+// @Summary Update a security group on Aruba Cloud
+// @Description Update a security group on Aruba Cloud using the provided project, VPC and security group details.
+// @ID put-security-group
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param id path string true "Security Group ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param securityGroupUpdate body FlattenedUpdateSecurityGroupRequestDto true "Security group update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedSecurityGroupResponseDto "Security group details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/securityGroups/{id} [put]
+func PutSecurityGroup(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateSecurityGroupRequestDto, SecurityGroupDto, SecurityGroupResponseDto](opts, SecurityGroup,
+		func(req FlattenedUpdateSecurityGroupRequestDto) SecurityGroupDto {
+			return SecurityGroupDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListSecurityGroups
+// @Summary List security groups on Aruba Cloud
+// @Description List security groups on Aruba Cloud using the provided project and VPC details.
+// @ID list-security-groups
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedSecurityGroupListResponseDto "A list of security groups"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/securityGroups [get]
+func ListSecurityGroups(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[SecurityGroupResponseDto, FlattenedSecurityGroupResponseDto](opts, SecurityGroup)
+}
+
+// DeleteSecurityGroup
+// @Summary Delete a security group on Aruba Cloud
+// @Description Delete a security group on Aruba Cloud using the provided project, VPC and security group details.
+// @Description Deleting a security group that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-security-group
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param id path string true "Security Group ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/securityGroups/{id} [delete]
+func DeleteSecurityGroup(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, SecurityGroup)
+}
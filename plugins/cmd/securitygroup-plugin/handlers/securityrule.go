@@ -0,0 +1,236 @@
+package securitygroup
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// SecurityRule is the Aruba Cloud security rule resource, scoped to a security group
+var SecurityRule = handlers.Resource{
+	Name:   "security rule",
+	Plural: "security rules",
+	Path:   "/projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/securityGroups/{securityGroupId}/securityRules",
+	PathParams: []handlers.Param{
+		handlers.ProjectIDParam,
+		{Name: "vpcId", Label: "VPC ID"},
+		{Name: "securityGroupId", Label: "Security Group ID"},
+	},
+	IDParam:       handlers.Param{Name: "id", Label: "Security Rule ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetSecurityRule
+// @Summary Get a security rule from Aruba Cloud
+// @Description Get a security rule from Aruba Cloud using the provided project, VPC, security group and security rule details.
+// @ID get-security-rule
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param securityGroupId path string true "Security Group ID"
+// @Param id path string true "Security Rule ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedSecurityRuleResponseDto "Security rule details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/securityGroups/{securityGroupId}/securityRules/{id} [get]
+func GetSecurityRule(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[SecurityRuleResponseDto](opts, SecurityRule)
+}
+
+// PostSecurityRule
+// @Summary Create a new security rule on Aruba Cloud
+// @Description Create a new security rule on Aruba Cloud using the provided project, VPC and security group details.
+// @Description The direction, protocol, port range and target of the rule are validated before calling Aruba Cloud.
+// @ID post-security-rule
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param securityGroupId path string true "Security Group ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param securityRuleCreate body FlattenedCreateSecurityRuleRequestDto true "Security rule creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedSecurityRuleResponseDto "Security rule details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/securityGroups/{securityGroupId}/securityRules [post]
+func PostSecurityRule(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateSecurityRuleRequestDto, SecurityRuleDto, SecurityRuleResponseDto](opts, SecurityRule,
+		func(req FlattenedCreateSecurityRuleRequestDto) SecurityRuleDto {
+			return SecurityRuleDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutSecurityRule
+// @Summary Update a security rule on Aruba Cloud
+// @Description Update a security rule on Aruba Cloud using the provided project, VPC, security group and security rule details.
+// @Description The direction, protocol, port range and target of the rule are validated before calling Aruba Cloud.
+// @ID put-security-rule
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param securityGroupId path string true "Security Group ID"
+// @Param id path string true "Security Rule ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param securityRuleUpdate body FlattenedUpdateSecurityRuleRequestDto true "Security rule update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedSecurityRuleResponseDto "Security rule details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/securityGroups/{securityGroupId}/securityRules/{id} [put]
+func PutSecurityRule(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateSecurityRuleRequestDto, SecurityRuleDto, SecurityRuleResponseDto](opts, SecurityRule,
+		func(req FlattenedUpdateSecurityRuleRequestDto) SecurityRuleDto {
+			return SecurityRuleDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListSecurityRules
+// @Summary List security rules on Aruba Cloud
+// @Description List security rules on Aruba Cloud using the provided project, VPC and security group details.
+// @ID list-security-rules
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param securityGroupId path string true "Security Group ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedSecurityRuleListResponseDto "A list of security rules"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/securityGroups/{securityGroupId}/securityRules [get]
+func ListSecurityRules(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[SecurityRuleResponseDto, FlattenedSecurityRuleResponseDto](opts, SecurityRule)
+}
+
+// DeleteSecurityRule
+// @Summary Delete a security rule on Aruba Cloud
+// @Description Delete a security rule on Aruba Cloud using the provided project, VPC, security group and security rule details.
+// @Description Deleting a security rule that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-security-rule
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param securityGroupId path string true "Security Group ID"
+// @Param id path string true "Security Rule ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/securityGroups/{securityGroupId}/securityRules/{id} [delete]
+func DeleteSecurityRule(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, SecurityRule)
+}
+
+// Rule properties accepted by Aruba Cloud
+const (
+	DirectionIngress = "Ingress"
+	DirectionEgress  = "Egress"
+
+	ProtocolAny  = "ANY"
+	ProtocolTCP  = "TCP"
+	ProtocolUDP  = "UDP"
+	ProtocolICMP = "ICMP"
+
+	TargetKindIP            = "Ip"
+	TargetKindSecurityGroup = "SecurityGroup"
+)
+
+// Validate checks the rule before it is sent to Aruba Cloud
+func (req FlattenedCreateSecurityRuleRequestDto) Validate() error {
+	return validateRuleProperties(req.Properties)
+}
+
+// Validate checks the rule before it is sent to Aruba Cloud
+func (req FlattenedUpdateSecurityRuleRequestDto) Validate() error {
+	return validateRuleProperties(req.Properties)
+}
+
+// validateRuleProperties checks the direction, protocol, port range and target of a rule
+func validateRuleProperties(props *SecurityRulePropertiesDto) error {
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if err := validation.OneOf("properties.direction", props.Direction, DirectionIngress, DirectionEgress); err != nil {
+		return err
+	}
+	if err := validation.OneOf("properties.protocol", props.Protocol, ProtocolAny, ProtocolTCP, ProtocolUDP, ProtocolICMP); err != nil {
+		return err
+	}
+
+	// The port is only meaningful for TCP and UDP, where it is required
+	switch {
+	case props.Protocol == ProtocolTCP || props.Protocol == ProtocolUDP:
+		if props.Port == "" {
+			return fmt.Errorf("properties.port is required for the %s protocol", props.Protocol)
+		}
+		if err := validation.PortRange(props.Port); err != nil {
+			return fmt.Errorf("properties.port: %w", err)
+		}
+	case props.Port != "" && props.Port != validation.AnyPort:
+		return fmt.Errorf("properties.port is not allowed for the %s protocol", props.Protocol)
+	}
+
+	target := props.Target
+	if target == nil {
+		return errors.New("properties.target is required")
+	}
+	if err := validation.OneOf("properties.target.kind", target.Kind, TargetKindIP, TargetKindSecurityGroup); err != nil {
+		return err
+	}
+	switch target.Kind {
+	case TargetKindIP:
+		if _, err := validation.CIDR(target.Value); err != nil {
+			return fmt.Errorf("properties.target.value: %w", err)
+		}
+	case TargetKindSecurityGroup:
+		if !strings.Contains(target.Value, "/securityGroups/") {
+			return fmt.Errorf("properties.target.value must be the URI of a security group, got '%s'", target.Value)
+		}
+	}
+	return nil
+}
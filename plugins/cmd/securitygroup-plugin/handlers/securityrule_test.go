@@ -0,0 +1,108 @@
+package securitygroup
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// newTestMux serves the security rule handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	mux := http.NewServeMux()
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/securityGroups/{securityGroupId}/securityRules", PostSecurityRule(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/securityGroups/{securityGroupId}/securityRules/{id}", PutSecurityRule(opts))
+	return mux, calls
+}
+
+const (
+	rulesURI = "/projects/p1/providers/Aruba.Network/vpcs/vpc1/securityGroups/sg1/securityRules?api-version=1.0"
+	ruleURI  = "/projects/p1/providers/Aruba.Network/vpcs/vpc1/securityGroups/sg1/securityRules/r1?api-version=1.0"
+)
+
+// TestSecurityRuleHandlers tests that the valid rules are sent to Aruba Cloud unchanged
+func TestSecurityRuleHandlers(t *testing.T) {
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		properties     string
+		expectedStatus int
+	}{
+		{name: "tcp port", method: http.MethodPost, target: rulesURI, properties: `{"direction":"Ingress","protocol":"TCP","port":"443","target":{"kind":"Ip","value":"0.0.0.0/0"}}`, expectedStatus: http.StatusCreated},
+		{name: "udp port range", method: http.MethodPost, target: rulesURI, properties: `{"direction":"Egress","protocol":"UDP","port":"8000-8080","target":{"kind":"Ip","value":"10.0.0.0/24"}}`, expectedStatus: http.StatusCreated},
+		{name: "tcp any port", method: http.MethodPost, target: rulesURI, properties: `{"direction":"Ingress","protocol":"TCP","port":"*","target":{"kind":"Ip","value":"10.0.0.5"}}`, expectedStatus: http.StatusCreated},
+		{name: "icmp without port", method: http.MethodPost, target: rulesURI, properties: `{"direction":"Ingress","protocol":"ICMP","target":{"kind":"Ip","value":"0.0.0.0/0"}}`, expectedStatus: http.StatusCreated},
+		{name: "any protocol with any port", method: http.MethodPost, target: rulesURI, properties: `{"direction":"Egress","protocol":"ANY","port":"*","target":{"kind":"Ip","value":"0.0.0.0/0"}}`, expectedStatus: http.StatusCreated},
+		{name: "security group target", method: http.MethodPost, target: rulesURI, properties: `{"direction":"Ingress","protocol":"TCP","port":"22","target":{"kind":"SecurityGroup","value":"/projects/p1/providers/Aruba.Network/vpcs/vpc1/securityGroups/sg2"}}`, expectedStatus: http.StatusCreated},
+		{name: "update", method: http.MethodPut, target: ruleURI, properties: `{"direction":"Ingress","protocol":"TCP","port":"80","target":{"kind":"Ip","value":"0.0.0.0/0"}}`, expectedStatus: http.StatusOK},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.expectedStatus)
+				w.Write([]byte(`{"metadata":{"id":"r1","name":"rule"}}`))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, `{"name":"rule","properties":`+tc.properties+`}`)
+
+			expectedCall := handlertest.Call{Method: tc.method, URI: tc.target, Body: `{"metadata":{"name":"rule"},"properties":` + tc.properties + `}`}
+			if len(*calls) != 1 || (*calls)[0] != expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+		})
+	}
+}
+
+// TestSecurityRuleHandlers_Validation tests that the rules with an invalid direction, protocol, port range or target are rejected before calling Aruba Cloud
+func TestSecurityRuleHandlers_Validation(t *testing.T) {
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedDetail string
+	}{
+		{name: "no properties", method: http.MethodPost, target: rulesURI, body: `{"name":"rule"}`, expectedDetail: "properties are required"},
+		{name: "unknown direction", method: http.MethodPost, target: rulesURI, body: `{"properties":{"direction":"Inbound","protocol":"TCP","port":"443","target":{"kind":"Ip","value":"0.0.0.0/0"}}}`, expectedDetail: "properties.direction must be one of Ingress, Egress, got 'Inbound'"},
+		{name: "unknown protocol", method: http.MethodPost, target: rulesURI, body: `{"properties":{"direction":"Ingress","protocol":"SCTP","port":"443","target":{"kind":"Ip","value":"0.0.0.0/0"}}}`, expectedDetail: "properties.protocol must be one of ANY, TCP, UDP, ICMP, got 'SCTP'"},
+		{name: "tcp without port", method: http.MethodPost, target: rulesURI, body: `{"properties":{"direction":"Ingress","protocol":"TCP","target":{"kind":"Ip","value":"0.0.0.0/0"}}}`, expectedDetail: "properties.port is required for the TCP protocol"},
+		{name: "port out of range", method: http.MethodPost, target: rulesURI, body: `{"properties":{"direction":"Ingress","protocol":"UDP","port":"70000","target":{"kind":"Ip","value":"0.0.0.0/0"}}}`, expectedDetail: "properties.port: invalid port '70000': must be a number between 1 and 65535"},
+		{name: "reversed port range", method: http.MethodPost, target: rulesURI, body: `{"properties":{"direction":"Ingress","protocol":"TCP","port":"8080-8000","target":{"kind":"Ip","value":"0.0.0.0/0"}}}`, expectedDetail: "properties.port: invalid port range '8080-8000': the first port is greater than the last one"},
+		{name: "icmp with port", method: http.MethodPost, target: rulesURI, body: `{"properties":{"direction":"Ingress","protocol":"ICMP","port":"443","target":{"kind":"Ip","value":"0.0.0.0/0"}}}`, expectedDetail: "properties.port is not allowed for the ICMP protocol"},
+		{name: "no target", method: http.MethodPost, target: rulesURI, body: `{"properties":{"direction":"Ingress","protocol":"TCP","port":"443"}}`, expectedDetail: "properties.target is required"},
+		{name: "unknown target kind", method: http.MethodPost, target: rulesURI, body: `{"properties":{"direction":"Ingress","protocol":"TCP","port":"443","target":{"kind":"Subnet","value":"10.0.0.0/24"}}}`, expectedDetail: "properties.target.kind must be one of Ip, SecurityGroup, got 'Subnet'"},
+		{name: "invalid cidr", method: http.MethodPost, target: rulesURI, body: `{"properties":{"direction":"Ingress","protocol":"TCP","port":"443","target":{"kind":"Ip","value":"10.0.0.0/33"}}}`, expectedDetail: "properties.target.value: invalid CIDR '10.0.0.0/33'"},
+		{name: "security group target not a uri on update", method: http.MethodPut, target: ruleURI, body: `{"properties":{"direction":"Ingress","protocol":"TCP","port":"443","target":{"kind":"SecurityGroup","value":"sg2"}}}`, expectedDetail: "properties.target.value must be the URI of a security group, got 'sg2'"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 0 {
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			}
+			if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+				t.Fatalf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+			}
+			var problem handlers.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,335 @@
+package securitygroup
+
+type SecurityGroupDto struct {
+	Metadata   *MetadataDto                `json:"metadata,omitempty"`
+	Properties *SecurityGroupPropertiesDto `json:"properties,omitempty"`
+}
+
+type MetadataDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+}
+
+type LocationDto struct {
+	// Value is the region where the resource will be located.
+	// Available regions at present: ITBG-Bergamo.
+	Value string `json:"value,omitempty"`
+}
+
+type SecurityGroupPropertiesDto struct {
+	// Default indicates if the security group must be the default security group of the VPC.
+	Default bool `json:"default,omitempty"`
+}
+
+type SecurityRuleDto struct {
+	Metadata   *MetadataDto               `json:"metadata,omitempty"`
+	Properties *SecurityRulePropertiesDto `json:"properties,omitempty"`
+}
+
+type SecurityRulePropertiesDto struct {
+	// Direction is the direction of the traffic matched by the rule.
+	// Allowed values: Ingress, Egress.
+	Direction string `json:"direction,omitempty"`
+	// Protocol is the protocol of the traffic matched by the rule.
+	// Allowed values: ANY, TCP, UDP, ICMP.
+	Protocol string `json:"protocol,omitempty"`
+	// Port is the port or port range matched by the rule, e.g. "443", "8000-8080" or "*" for any port.
+	// Required for the TCP and UDP protocols.
+	Port string `json:"port,omitempty"`
+	// Target is the remote end of the traffic matched by the rule.
+	Target *RuleTargetDto `json:"target,omitempty"`
+}
+
+type RuleTargetDto struct {
+	// Kind is the kind of the target.
+	// Allowed values: Ip, SecurityGroup.
+	Kind string `json:"kind,omitempty"`
+	// Value is the CIDR of the target when Kind is Ip (e.g. "0.0.0.0/0"),
+	// the URI of the security group when Kind is SecurityGroup.
+	Value string `json:"value,omitempty"`
+}
+
+type SecurityGroupResponseDto struct {
+	Metadata   *MetadataResponseDto                `json:"metadata,omitempty"`
+	Status     *StatusResponseDto                  `json:"status,omitempty"`
+	Properties *SecurityGroupPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type SecurityRuleResponseDto struct {
+	Metadata   *MetadataResponseDto               `json:"metadata,omitempty"`
+	Status     *StatusResponseDto                 `json:"status,omitempty"`
+	Properties *SecurityRulePropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type MetadataResponseDto struct {
+	ID           string               `json:"id,omitempty"`
+	URI          string               `json:"uri,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	Location     *LocationResponseDto `json:"location,omitempty"`
+	Project      *ProjectResponseDto  `json:"project,omitempty"`
+	Tags         []string             `json:"tags,omitempty"`
+	Category     *CategoryResponseDto `json:"category,omitempty"`
+	CreationDate string               `json:"creationDate,omitempty"`
+	CreatedBy    string               `json:"createdBy,omitempty"`
+	UpdateDate   string               `json:"updateDate,omitempty"`
+	UpdatedBy    string               `json:"updatedBy,omitempty"`
+	Version      string               `json:"version,omitempty"`
+	CreatedUser  string               `json:"createdUser,omitempty"`
+	UpdatedUser  string               `json:"updatedUser,omitempty"`
+}
+
+type LocationResponseDto struct {
+	// Code is the code of the region.
+	Code string `json:"code,omitempty"`
+	// Country is the country of the region.
+	Country string `json:"country,omitempty"`
+	// City is the city of the region.
+	City string `json:"city,omitempty"`
+	// Name is the name of the region.
+	Name string `json:"name,omitempty"`
+	// Value is the value of the region.
+	Value string `json:"value,omitempty"`
+}
+
+type ProjectResponseDto struct {
+	// ID is the unique identifier of the project.
+	ID string `json:"id,omitempty"`
+}
+
+type CategoryResponseDto struct {
+	// Name is the name of the category.
+	Name string `json:"name,omitempty"`
+	// Provider is the provider of the category.
+	Provider string `json:"provider,omitempty"`
+	// Typology is the typology of the category.
+	Typology *TypologyResponseDto `json:"typology,omitempty"`
+}
+
+type TypologyResponseDto struct {
+	// ID is the unique identifier of the typology.
+	ID string `json:"id,omitempty"`
+	// Name is the name of the typology.
+	Name string `json:"name,omitempty"`
+}
+
+type StatusResponseDto struct {
+	// State is the state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the status.
+	CreationDate string `json:"creationDate,omitempty"`
+	// DisableStatusInfo contains the information about the disabled status of the resource.
+	DisableStatusInfo *DisableStatusInfoResponseDto `json:"disableStatusInfo,omitempty"`
+	// FailureReason is the reason of the failure, if any.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+type DisableStatusInfoResponseDto struct {
+	// IsDisabled indicates if the resource is disabled.
+	IsDisabled bool `json:"isDisabled,omitempty"`
+	// Reasons is a list of reasons for the disabled status.
+	Reasons []string `json:"reasons,omitempty"`
+	// PreviousStatus is the previous status of the resource.
+	PreviousStatus *PreviousStatusResponseDto `json:"previousStatus,omitempty"`
+}
+
+type PreviousStatusResponseDto struct {
+	// State is the previous state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the previous status.
+	CreationDate string `json:"creationDate,omitempty"`
+}
+
+type SecurityGroupPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the security group.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Default indicates if the security group is the default security group of the VPC.
+	Default bool `json:"default,omitempty"`
+}
+
+type SecurityRulePropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the security rule, e.g. its security group.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Direction is the direction of the traffic matched by the rule.
+	Direction string `json:"direction,omitempty"`
+	// Protocol is the protocol of the traffic matched by the rule.
+	Protocol string `json:"protocol,omitempty"`
+	// Port is the port or port range matched by the rule.
+	Port string `json:"port,omitempty"`
+	// Target is the remote end of the traffic matched by the rule.
+	Target *RuleTargetDto `json:"target,omitempty"`
+}
+
+type LinkedResourceResponseDto struct {
+	// URI is the URI of the linked resource.
+	URI string `json:"uri,omitempty"`
+	// StrictCorrelation indicates if the correlation is strict.
+	StrictCorrelation bool `json:"strictCorrelation,omitempty"`
+}
+
+// --------------------------------------------------------------------------
+// Flattened types
+// --------------------------------------------------------------------------
+
+// FlattenedCreateSecurityGroupRequestDto is the flattened request body for creating a security group.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateSecurityGroupRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the security group.
+	Properties *SecurityGroupPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateSecurityGroupRequestDto is the flattened request body for updating a security group.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateSecurityGroupRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the security group.
+	Properties *SecurityGroupPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedSecurityGroupResponseDto is the flattened response body for a single security group.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedSecurityGroupResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the security group.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the security group.
+	Properties *SecurityGroupPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedSecurityGroupListResponseDto struct {
+	// Total is the total number of security groups.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened security groups.
+	Values []FlattenedSecurityGroupResponseDto `json:"values,omitempty"`
+}
+
+// FlattenedCreateSecurityRuleRequestDto is the flattened request body for creating a security rule.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateSecurityRuleRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the security rule.
+	Properties *SecurityRulePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateSecurityRuleRequestDto is the flattened request body for updating a security rule.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateSecurityRuleRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the security rule.
+	Properties *SecurityRulePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedSecurityRuleResponseDto is the flattened response body for a single security rule.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedSecurityRuleResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the security rule.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the security rule.
+	Properties *SecurityRulePropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedSecurityRuleListResponseDto struct {
+	// Total is the total number of security rules.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened security rules.
+	Values []FlattenedSecurityRuleResponseDto `json:"values,omitempty"`
+}
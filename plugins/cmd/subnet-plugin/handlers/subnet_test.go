@@ -0,0 +1,120 @@
+package subnet
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// newTestMux serves the subnet handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	mux := http.NewServeMux()
+	mux.Handle("DELETE /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/subnets/{id}", DeleteSubnet(opts))
+	return mux, calls
+}
+
+// TestDeleteSubnet tests the handover of the default subnet and the idempotent deletion of the subnets
+func TestDeleteSubnet(t *testing.T) {
+	const subnetURI = "/projects/p1/providers/Aruba.Network/vpcs/vpc1/subnets/s1"
+
+	testCases := []struct {
+		name           string
+		target         string
+		deleteStatus   int
+		getStatus      int
+		getBody        string
+		expectedCalls  []handlertest.Call
+		expectedStatus int
+	}{
+		{
+			name:           "accepted",
+			target:         subnetURI + "?api-version=1.0",
+			deleteStatus:   http.StatusAccepted,
+			expectedCalls:  []handlertest.Call{{Method: http.MethodDelete, URI: subnetURI + "?api-version=1.0"}},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "default subnet handover",
+			target:         subnetURI + "?api-version=1.0&newDefaultSubnet=%2Fsubnets%2Fs2",
+			deleteStatus:   http.StatusAccepted,
+			expectedCalls:  []handlertest.Call{{Method: http.MethodDelete, URI: subnetURI + "?api-version=1.0&newDefaultSubnet=%2Fsubnets%2Fs2"}},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "not found",
+			target:         subnetURI + "?api-version=1.0",
+			deleteStatus:   http.StatusNotFound,
+			expectedCalls:  []handlertest.Call{{Method: http.MethodDelete, URI: subnetURI + "?api-version=1.0"}},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:         "already deleted",
+			target:       subnetURI + "?api-version=1.0&newDefaultSubnet=%2Fsubnets%2Fs2",
+			deleteStatus: http.StatusConflict,
+			getStatus:    http.StatusOK,
+			getBody:      `{"metadata":{"id":"s1"},"status":{"state":"Deleted"}}`,
+			expectedCalls: []handlertest.Call{
+				{Method: http.MethodDelete, URI: subnetURI + "?api-version=1.0&newDefaultSubnet=%2Fsubnets%2Fs2"},
+				{Method: http.MethodGet, URI: subnetURI + "?api-version=1.0"},
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:         "in use",
+			target:       subnetURI + "?api-version=1.0",
+			deleteStatus: http.StatusConflict,
+			getStatus:    http.StatusOK,
+			getBody:      `{"metadata":{"id":"s1"},"status":{"state":"InUse"}}`,
+			expectedCalls: []handlertest.Call{
+				{Method: http.MethodDelete, URI: subnetURI + "?api-version=1.0"},
+				{Method: http.MethodGet, URI: subnetURI + "?api-version=1.0"},
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					w.WriteHeader(tc.deleteStatus)
+					return
+				}
+				w.WriteHeader(tc.getStatus)
+				w.Write([]byte(tc.getBody))
+			})
+
+			rec := handlertest.Serve(mux, http.MethodDelete, tc.target, "")
+
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if len(*calls) != len(tc.expectedCalls) {
+				t.Fatalf("expected the upstream calls %+v, got %+v", tc.expectedCalls, *calls)
+			}
+			for i, call := range tc.expectedCalls {
+				if (*calls)[i] != call {
+					t.Errorf("expected the upstream call %+v, got %+v", call, (*calls)[i])
+				}
+			}
+		})
+	}
+}
+
+// TestDeleteSubnet_Validation tests that incomplete requests are rejected before calling Aruba Cloud
+func TestDeleteSubnet_Validation(t *testing.T) {
+	mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+	rec := handlertest.Serve(mux, http.MethodDelete, "/projects/p1/providers/Aruba.Network/vpcs/vpc1/subnets/s1", "")
+
+	if len(*calls) != 0 {
+		t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+	}
+	if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+		t.Errorf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+	}
+}
@@ -176,14 +176,6 @@ type SubnetListResponseDto struct {
 	Values []SubnetResponseDto `json:"values,omitempty"`
 }
 
-type ProblemDetails struct {
-	Type     string `json:"type,omitempty"`
-	Title    string `json:"title,omitempty"`
-	Status   int32  `json:"status,omitempty"`
-	Detail   string `json:"detail,omitempty"`
-	Instance string `json:"instance,omitempty"`
-}
-
 // --------------------------------------------------------------------------
 // Flattened types
 // --------------------------------------------------------------------------
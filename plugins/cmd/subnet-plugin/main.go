@@ -1,13 +1,10 @@
 package main
 
 import (
-	"net/http"
-
 	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
 	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/health"
 	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/server"
 	subnet "github.com/krateoplatformops/arubacloud-provider-kog/subnet-plugin/handlers"
-	"github.com/rs/zerolog/log"
 	httpSwagger "github.com/swaggo/http-swagger"
 )
 
@@ -27,8 +24,11 @@ func main() {
 	srv := server.New()
 
 	opts := handlers.HandlerOptions{
-		Log:    &log.Logger,
-		Client: http.DefaultClient,
+		Log:      srv.Logger(),
+		Client:   srv.HTTPClient(),
+		BaseURL:  srv.BaseURL(),
+		Auth:     srv.TokenProvider(),
+		Timeouts: srv.UpstreamTimeouts(),
 	}
 
 	// Subnet
@@ -36,13 +36,14 @@ func main() {
 	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/subnets", subnet.ListSubnets(opts))
 	srv.Mux().Handle("GET /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/subnets/{id}", subnet.GetSubnet(opts))
 	srv.Mux().Handle("PUT /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/subnets/{id}", subnet.PutSubnet(opts))
+	srv.Mux().Handle("DELETE /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/subnets/{id}", subnet.DeleteSubnet(opts))
 
 	// Swagger UI
 	srv.Mux().Handle("/swagger/", httpSwagger.WrapHandler)
 
 	// Kubernetes health check endpoints
 	srv.Mux().HandleFunc("GET /healthz", health.LivenessHandler(srv.Healthy()))
-	srv.Mux().HandleFunc("GET /readyz", health.ReadinessHandler(srv.Ready(), opts.Client.(*http.Client)))
+	srv.Mux().HandleFunc("GET /readyz", srv.ReadinessHandler())
 
 	srv.Run()
 }
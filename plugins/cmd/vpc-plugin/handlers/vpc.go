@@ -0,0 +1,146 @@
+package vpc
+
+import (
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+)
+
+// Vpc is the Aruba Cloud VPC resource
+var Vpc = handlers.Resource{
+	Name:          "vpc",
+	Plural:        "vpcs",
+	Path:          "/projects/{projectId}/providers/Aruba.Network/vpcs",
+	PathParams:    []handlers.Param{handlers.ProjectIDParam},
+	IDParam:       handlers.Param{Name: "id", Label: "VPC ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetVpc
+// @Summary Get a VPC from Aruba Cloud
+// @Description Get a VPC from Aruba Cloud using the provided project and VPC details.
+// @ID get-vpc
+// @Param projectId path string true "Project ID"
+// @Param id path string true "VPC ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpcResponseDto "VPC details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{id} [get]
+func GetVpc(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[VpcResponseDto](opts, Vpc)
+}
+
+// PostVpc
+// @Summary Create a new VPC on Aruba Cloud
+// @Description Create a new VPC on Aruba Cloud using the provided project details.
+// @ID post-vpc
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param vpcCreate body FlattenedCreateVpcRequestDto true "VPC creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedVpcResponseDto "VPC details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs [post]
+func PostVpc(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateVpcRequestDto, VpcDto, VpcResponseDto](opts, Vpc,
+		func(req FlattenedCreateVpcRequestDto) VpcDto {
+			return VpcDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutVpc
+// @Summary Update a VPC on Aruba Cloud
+// @Description Update a VPC on Aruba Cloud using the provided project and VPC details.
+// @ID put-vpc
+// @Param projectId path string true "Project ID"
+// @Param id path string true "VPC ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param vpcUpdate body FlattenedUpdateVpcRequestDto true "VPC update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpcResponseDto "VPC details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{id} [put]
+func PutVpc(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateVpcRequestDto, VpcUpdateDto, VpcResponseDto](opts, Vpc,
+		func(req FlattenedUpdateVpcRequestDto) VpcUpdateDto {
+			return VpcUpdateDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListVpcs
+// @Summary List VPCs on Aruba Cloud
+// @Description List VPCs on Aruba Cloud using the provided project details.
+// @ID list-vpcs
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpcListResponseDto "A list of VPCs"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs [get]
+func ListVpcs(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[VpcResponseDto, FlattenedVpcResponseDto](opts, Vpc)
+}
+
+// DeleteVpc
+// @Summary Delete a VPC on Aruba Cloud
+// @Description Delete a VPC on Aruba Cloud using the provided project and VPC details.
+// @Description Deleting a VPC that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-vpc
+// @Param projectId path string true "Project ID"
+// @Param id path string true "VPC ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{id} [delete]
+func DeleteVpc(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, Vpc)
+}
@@ -0,0 +1,113 @@
+package vpc
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// newTestMux serves the VPC handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	mux := http.NewServeMux()
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Network/vpcs", ListVpcs(opts))
+	mux.Handle("GET /projects/{projectId}/providers/Aruba.Network/vpcs/{id}", GetVpc(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Network/vpcs", PostVpc(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Network/vpcs/{id}", PutVpc(opts))
+	mux.Handle("DELETE /projects/{projectId}/providers/Aruba.Network/vpcs/{id}", DeleteVpc(opts))
+	return mux, calls
+}
+
+// TestVpcHandlers tests the unflattening of the requests sent to Aruba Cloud and the flattening of the responses
+func TestVpcHandlers(t *testing.T) {
+	const vpcsURI = "/projects/p1/providers/Aruba.Network/vpcs"
+	vpc := `{"metadata":{"id":"vpc1","name":"net","location":{"value":"ITBG-Bergamo"}},"status":{"state":"Active"},"properties":{"default":true,"preset":true}}`
+	flattened := `{"id":"vpc1","location":{"value":"ITBG-Bergamo"},"name":"net","properties":{"default":true,"preset":true},"status":{"state":"Active"}}`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		upstreamStatus int
+		upstreamBody   string
+		expectedCall   handlertest.Call
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "get",
+			method:         http.MethodGet,
+			target:         vpcsURI + "/vpc1?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   vpc,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: vpcsURI + "/vpc1?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "list",
+			method:         http.MethodGet,
+			target:         vpcsURI + "?api-version=1.0",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"total":1,"values":[` + vpc + `]}`,
+			expectedCall:   handlertest.Call{Method: http.MethodGet, URI: vpcsURI + "?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"total":1,"values":[{"id":"vpc1","name":"net","location":{"value":"ITBG-Bergamo"},"status":{"state":"Active"},"properties":{"default":true,"preset":true}}]}`,
+		},
+		{
+			name:           "create",
+			method:         http.MethodPost,
+			target:         vpcsURI + "?api-version=1.0",
+			body:           `{"name":"net","location":{"value":"ITBG-Bergamo"},"tags":["prod"],"properties":{"default":true,"preset":true}}`,
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   vpc,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: vpcsURI + "?api-version=1.0", Body: `{"metadata":{"name":"net","location":{"value":"ITBG-Bergamo"},"tags":["prod"]},"properties":{"default":true,"preset":true}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "update leaves out the preset",
+			method:         http.MethodPut,
+			target:         vpcsURI + "/vpc1?api-version=1.0",
+			body:           `{"name":"net","properties":{"default":true,"preset":true}}`,
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   vpc,
+			expectedCall:   handlertest.Call{Method: http.MethodPut, URI: vpcsURI + "/vpc1?api-version=1.0", Body: `{"metadata":{"name":"net"},"properties":{"default":true}}`},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "delete",
+			method:         http.MethodDelete,
+			target:         vpcsURI + "/vpc1?api-version=1.0",
+			upstreamStatus: http.StatusAccepted,
+			expectedCall:   handlertest.Call{Method: http.MethodDelete, URI: vpcsURI + "/vpc1?api-version=1.0"},
+			expectedStatus: http.StatusAccepted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.upstreamStatus)
+				w.Write([]byte(tc.upstreamBody))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 1 || (*calls)[0] != tc.expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", tc.expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tc.expectedBody {
+				t.Errorf("expected body '%s', got '%s'", tc.expectedBody, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package vpcpeering
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/logging"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// subnetsPageSize is the number of subnets of the local VPC read per Aruba Cloud request
+const subnetsPageSize = 100
+
+// localSubnet is the part of an Aruba Cloud subnet compared with the destination of a route
+type localSubnet struct {
+	Metadata *struct {
+		Name string `json:"name,omitempty"`
+	} `json:"metadata,omitempty"`
+	Properties *struct {
+		Network *struct {
+			Address string `json:"address,omitempty"`
+		} `json:"network,omitempty"`
+	} `json:"properties,omitempty"`
+}
+
+// localSubnetsCheck rejects the routes whose destination overlaps a subnet of the local VPC,
+// which would never reach the remote VPC, before handing the request to the route handler
+type localSubnetsCheck struct {
+	*handlers.Base
+	next handlers.Handler
+}
+
+// withLocalSubnetsCheck returns next preceded by the check of the destination of the route against the subnets of the local VPC
+func withLocalSubnetsCheck(opts handlers.HandlerOptions, next handlers.Handler) handlers.Handler {
+	return &localSubnetsCheck{Base: handlers.NewBase(opts), next: next}
+}
+
+func (h *localSubnetsCheck) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.WriteErrorResponse(w, r, http.StatusBadRequest, "Failed to read request body")
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	// Invalid requests are answered by the route handler, the check only applies to valid destinations
+	var req struct {
+		Properties *VpcPeeringRoutePropertiesDto `json:"properties,omitempty"`
+	}
+	if json.Unmarshal(body, &req) != nil || validateRoute(req.Properties) != nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	projectID, vpcID, apiVersion := r.PathValue("projectId"), r.PathValue("vpcId"), r.URL.Query().Get("api-version")
+	if projectID == "" || vpcID == "" || apiVersion == "" {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+	authHeader, err := h.Authorization(r)
+	if err != nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	destination, _ := validation.CIDR(req.Properties.DestinationCidr)
+	r = h.WithRequestLogger(r, "projectId", "vpcId", "vpcPeeringId")
+	subnets, ok := h.listLocalSubnets(w, r, projectID, vpcID, apiVersion, authHeader)
+	if !ok {
+		return
+	}
+	for _, subnet := range subnets {
+		if subnet.Properties == nil || subnet.Properties.Network == nil {
+			continue
+		}
+		network, err := validation.CIDR(subnet.Properties.Network.Address)
+		if err != nil || !network.Overlaps(destination) {
+			continue
+		}
+		name := network.String()
+		if subnet.Metadata != nil && subnet.Metadata.Name != "" {
+			name = fmt.Sprintf("%s (%s)", subnet.Metadata.Name, network)
+		}
+		h.WriteErrorResponse(w, r, http.StatusBadRequest,
+			fmt.Sprintf("properties.destinationCidr %s overlaps the subnet %s of the local VPC", destination, name))
+		return
+	}
+
+	h.next.ServeHTTP(w, r)
+}
+
+// listLocalSubnets reads all the subnets of the local VPC, page by page.
+// On failure the error response is written and false is returned.
+func (h *localSubnetsCheck) listLocalSubnets(w http.ResponseWriter, r *http.Request, projectID, vpcID, apiVersion, authHeader string) ([]localSubnet, bool) {
+	ctx, cancel := h.Timeouts.WithUpstreamTimeout(r.Context(), handlers.OperationList)
+	defer cancel()
+
+	var subnets []localSubnet
+	for offset := 0; ; offset += subnetsPageSize {
+		query := url.Values{}
+		query.Set("api-version", apiVersion)
+		query.Set("offset", fmt.Sprint(offset))
+		query.Set("limit", fmt.Sprint(subnetsPageSize))
+		endpoint := fmt.Sprintf("%s/projects/%s/providers/Aruba.Network/vpcs/%s/subnets?%s", h.BaseURL, projectID, vpcID, query.Encode())
+
+		resp, err := h.MakeArubaCloudRequest(ctx, "list_local_subnets", http.MethodGet, endpoint, authHeader, nil)
+		if err != nil {
+			h.WriteUpstreamFailure(w, r, err, "Failed to make list subnets of the local VPC request")
+			return nil, false
+		}
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			h.WriteUpstreamFailure(w, r, err, "Failed to read list subnets of the local VPC response")
+			return nil, false
+		}
+		if resp.StatusCode != http.StatusOK {
+			h.Logger(r).Warn("Aruba Cloud API returned non-200 status for list subnets of the local VPC", logging.Fields{logging.FieldUpstreamStatus: resp.StatusCode, logging.FieldUpstreamBody: logging.RedactBody(respBody)})
+			h.WriteUpstreamErrorResponse(w, r, resp.StatusCode, respBody)
+			return nil, false
+		}
+
+		var page handlers.ListResponse[localSubnet]
+		if err := json.Unmarshal(respBody, &page); err != nil {
+			h.WriteErrorResponse(w, r, http.StatusInternalServerError, "Failed to parse the subnets of the local VPC")
+			return nil, false
+		}
+		subnets = append(subnets, page.Values...)
+		// Aruba Cloud may leave out the total, a short page is then the only end of the list
+		if len(page.Values) < subnetsPageSize || (page.Total > 0 && int64(len(subnets)) >= page.Total) {
+			return subnets, true
+		}
+	}
+}
+
+// Interface compliance verification
+var _ handlers.Handler = &localSubnetsCheck{}
@@ -0,0 +1,190 @@
+package vpcpeering
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// newTestMux serves the VPC peering route handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	mux := http.NewServeMux()
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings/{vpcPeeringId}/vpcPeeringRoutes", PostVpcPeeringRoute(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings/{vpcPeeringId}/vpcPeeringRoutes/{id}", PutVpcPeeringRoute(opts))
+	return mux, calls
+}
+
+// subnetsPage answers the list of the subnets of the local VPC with the page of subnets selected by offset and limit,
+// including the total only when withTotal is set
+func subnetsPage(w http.ResponseWriter, r *http.Request, subnets []string, withTotal bool) {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+	end := min(offset+limit, len(subnets))
+	values := []string{}
+	if offset < end {
+		values = subnets[offset:end]
+	}
+	total := ""
+	if withTotal {
+		total = fmt.Sprintf(`"total":%d,`, len(subnets))
+	}
+	fmt.Fprintf(w, `{%s"values":[%s]}`, total, strings.Join(values, ","))
+}
+
+// subnet is an Aruba Cloud subnet of the local VPC with the given name and address
+func subnet(name, address string) string {
+	return fmt.Sprintf(`{"metadata":{"name":"%s"},"properties":{"network":{"address":"%s"}}}`, name, address)
+}
+
+// TestLocalSubnetsCheck tests that the destination of the routes is checked against all the subnets of the local VPC
+func TestLocalSubnetsCheck(t *testing.T) {
+	const (
+		routesURI  = "/projects/p1/providers/Aruba.Network/vpcs/vpc1/vpcPeerings/peer1/vpcPeeringRoutes"
+		subnetsURI = "/projects/p1/providers/Aruba.Network/vpcs/vpc1/subnets"
+		route      = `{"metadata":{"id":"r1","name":"to-db"},"properties":{"destinationCidr":"10.200.0.0/16"}}`
+	)
+	request := `{"name":"to-db","properties":{"destinationCidr":"10.200.0.0/16","remoteSubnet":{"uri":"/projects/p2/providers/Aruba.Network/vpcs/vpc2/subnets/s1"}}}`
+	fullPage := make([]string, 0, subnetsPageSize)
+	for i := range subnetsPageSize {
+		fullPage = append(fullPage, subnet(fmt.Sprintf("app-%d", i), fmt.Sprintf("10.0.%d.0/24", i)))
+	}
+
+	testCases := []struct {
+		name             string
+		method           string
+		target           string
+		subnets          []string
+		withTotal        bool
+		listStatus       int
+		expectedListURIs []string
+		expectedRoute    bool
+		expectedStatus   int
+		expectedDetail   string
+	}{
+		{
+			name:             "no overlap",
+			method:           http.MethodPost,
+			target:           routesURI + "?api-version=1.0",
+			subnets:          []string{subnet("app", "10.0.0.0/24")},
+			withTotal:        true,
+			listStatus:       http.StatusOK,
+			expectedListURIs: []string{subnetsURI + "?api-version=1.0&limit=100&offset=0"},
+			expectedRoute:    true,
+			expectedStatus:   http.StatusCreated,
+		},
+		{
+			name:             "overlap",
+			method:           http.MethodPut,
+			target:           routesURI + "/r1?api-version=1.0",
+			subnets:          []string{subnet("app", "10.0.0.0/24"), subnet("db", "10.200.1.0/24")},
+			withTotal:        true,
+			listStatus:       http.StatusOK,
+			expectedListURIs: []string{subnetsURI + "?api-version=1.0&limit=100&offset=0"},
+			expectedStatus:   http.StatusBadRequest,
+			expectedDetail:   "properties.destinationCidr 10.200.0.0/16 overlaps the subnet db (10.200.1.0/24) of the local VPC",
+		},
+		{
+			name:       "overlap on the second page without total",
+			method:     http.MethodPost,
+			target:     routesURI + "?api-version=1.0",
+			subnets:    append(slices.Clone(fullPage), subnet("db", "10.200.1.0/24")),
+			listStatus: http.StatusOK,
+			expectedListURIs: []string{
+				subnetsURI + "?api-version=1.0&limit=100&offset=0",
+				subnetsURI + "?api-version=1.0&limit=100&offset=100",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedDetail: "properties.destinationCidr 10.200.0.0/16 overlaps the subnet db (10.200.1.0/24) of the local VPC",
+		},
+		{
+			name:       "full last page without total",
+			method:     http.MethodPost,
+			target:     routesURI + "?api-version=1.0",
+			subnets:    fullPage,
+			listStatus: http.StatusOK,
+			expectedListURIs: []string{
+				subnetsURI + "?api-version=1.0&limit=100&offset=0",
+				subnetsURI + "?api-version=1.0&limit=100&offset=100",
+			},
+			expectedRoute:  true,
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:             "full last page with total",
+			method:           http.MethodPost,
+			target:           routesURI + "?api-version=1.0",
+			subnets:          fullPage,
+			withTotal:        true,
+			listStatus:       http.StatusOK,
+			expectedListURIs: []string{subnetsURI + "?api-version=1.0&limit=100&offset=0"},
+			expectedRoute:    true,
+			expectedStatus:   http.StatusCreated,
+		},
+		{
+			name:             "failed list",
+			method:           http.MethodPost,
+			target:           routesURI + "?api-version=1.0",
+			listStatus:       http.StatusForbidden,
+			expectedListURIs: []string{subnetsURI + "?api-version=1.0&limit=100&offset=0"},
+			expectedStatus:   http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet {
+					w.WriteHeader(http.StatusCreated)
+					w.Write([]byte(route))
+					return
+				}
+				if tc.listStatus != http.StatusOK {
+					w.WriteHeader(tc.listStatus)
+					w.Write([]byte(`{"title":"Forbidden","status":403}`))
+					return
+				}
+				subnetsPage(w, r, tc.subnets, tc.withTotal)
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, request)
+
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			expectedCalls := len(tc.expectedListURIs)
+			if tc.expectedRoute {
+				expectedCalls++
+			}
+			if len(*calls) != expectedCalls {
+				t.Fatalf("expected %d upstream calls, got %+v", expectedCalls, *calls)
+			}
+			for i, uri := range tc.expectedListURIs {
+				if (*calls)[i].Method != http.MethodGet || (*calls)[i].URI != uri {
+					t.Errorf("expected the list of the local subnets '%s', got %+v", uri, (*calls)[i])
+				}
+			}
+			if tc.expectedRoute && (*calls)[expectedCalls-1].Method != tc.method {
+				t.Errorf("expected the route request %s, got %+v", tc.method, (*calls)[expectedCalls-1])
+			}
+			if tc.expectedDetail == "" {
+				return
+			}
+			var problem handlers.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
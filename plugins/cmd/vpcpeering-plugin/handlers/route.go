@@ -0,0 +1,193 @@
+package vpcpeering
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// VpcPeeringRoute is the Aruba Cloud route resource of a VPC peering, sending the traffic of a destination CIDR to a subnet of the remote VPC
+var VpcPeeringRoute = handlers.Resource{
+	Name:   "VPC peering route",
+	Plural: "VPC peering routes",
+	Path:   "/projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings/{vpcPeeringId}/vpcPeeringRoutes",
+	PathParams: []handlers.Param{
+		handlers.ProjectIDParam,
+		{Name: "vpcId", Label: "VPC ID"},
+		{Name: "vpcPeeringId", Label: "VPC Peering ID"},
+	},
+	IDParam:       handlers.Param{Name: "id", Label: "VPC Peering Route ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetVpcPeeringRoute
+// @Summary Get a VPC peering route from Aruba Cloud
+// @Description Get a VPC peering route from Aruba Cloud using the provided project, VPC, VPC peering and VPC peering route details.
+// @ID get-vpc-peering-route
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param vpcPeeringId path string true "VPC Peering ID"
+// @Param id path string true "VPC Peering Route ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpcPeeringRouteResponseDto "VPC peering route details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings/{vpcPeeringId}/vpcPeeringRoutes/{id} [get]
+func GetVpcPeeringRoute(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[VpcPeeringRouteResponseDto](opts, VpcPeeringRoute)
+}
+
+// PostVpcPeeringRoute
+// @Summary Create a new VPC peering route on Aruba Cloud
+// @Description Create a new VPC peering route on Aruba Cloud using the provided project, VPC and VPC peering details.
+// @Description The destination CIDR must not overlap the subnets of the local VPC, which are listed on Aruba Cloud before the route is created.
+// @ID post-vpc-peering-route
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param vpcPeeringId path string true "VPC Peering ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param vpcPeeringRouteCreate body FlattenedCreateVpcPeeringRouteRequestDto true "VPC peering route creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedVpcPeeringRouteResponseDto "VPC peering route details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings/{vpcPeeringId}/vpcPeeringRoutes [post]
+func PostVpcPeeringRoute(opts handlers.HandlerOptions) handlers.Handler {
+	return withLocalSubnetsCheck(opts, handlers.Create[FlattenedCreateVpcPeeringRouteRequestDto, VpcPeeringRouteDto, VpcPeeringRouteResponseDto](opts, VpcPeeringRoute,
+		func(req FlattenedCreateVpcPeeringRouteRequestDto) VpcPeeringRouteDto {
+			return VpcPeeringRouteDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		}))
+}
+
+// PutVpcPeeringRoute
+// @Summary Update a VPC peering route on Aruba Cloud
+// @Description Update a VPC peering route on Aruba Cloud using the provided project, VPC, VPC peering and VPC peering route details.
+// @Description The destination CIDR must not overlap the subnets of the local VPC, which are listed on Aruba Cloud before the route is updated.
+// @ID put-vpc-peering-route
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param vpcPeeringId path string true "VPC Peering ID"
+// @Param id path string true "VPC Peering Route ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param vpcPeeringRouteUpdate body FlattenedUpdateVpcPeeringRouteRequestDto true "VPC peering route update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpcPeeringRouteResponseDto "VPC peering route details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings/{vpcPeeringId}/vpcPeeringRoutes/{id} [put]
+func PutVpcPeeringRoute(opts handlers.HandlerOptions) handlers.Handler {
+	return withLocalSubnetsCheck(opts, handlers.Update[FlattenedUpdateVpcPeeringRouteRequestDto, VpcPeeringRouteDto, VpcPeeringRouteResponseDto](opts, VpcPeeringRoute,
+		func(req FlattenedUpdateVpcPeeringRouteRequestDto) VpcPeeringRouteDto {
+			return VpcPeeringRouteDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		}))
+}
+
+// ListVpcPeeringRoutes
+// @Summary List VPC peering routes on Aruba Cloud
+// @Description List VPC peering routes on Aruba Cloud using the provided project, VPC and VPC peering details.
+// @ID list-vpc-peering-routes
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param vpcPeeringId path string true "VPC Peering ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpcPeeringRouteListResponseDto "A list of VPC peering routes"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings/{vpcPeeringId}/vpcPeeringRoutes [get]
+func ListVpcPeeringRoutes(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[VpcPeeringRouteResponseDto, FlattenedVpcPeeringRouteResponseDto](opts, VpcPeeringRoute)
+}
+
+// DeleteVpcPeeringRoute
+// @Summary Delete a VPC peering route on Aruba Cloud
+// @Description Delete a VPC peering route on Aruba Cloud using the provided project, VPC, VPC peering and VPC peering route details.
+// @Description Deleting a VPC peering route that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-vpc-peering-route
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param vpcPeeringId path string true "VPC Peering ID"
+// @Param id path string true "VPC Peering Route ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings/{vpcPeeringId}/vpcPeeringRoutes/{id} [delete]
+func DeleteVpcPeeringRoute(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, VpcPeeringRoute)
+}
+
+// Validate checks the destination and the remote subnet of the route before it is sent to Aruba Cloud.
+// The overlap of the destination with the subnets of the local VPC is checked by withLocalSubnetsCheck.
+func (req FlattenedCreateVpcPeeringRouteRequestDto) Validate() error {
+	return validateRoute(req.Properties)
+}
+
+// Validate checks the destination and the remote subnet of the route before it is sent to Aruba Cloud
+func (req FlattenedUpdateVpcPeeringRouteRequestDto) Validate() error {
+	return validateRoute(req.Properties)
+}
+
+func validateRoute(props *VpcPeeringRoutePropertiesDto) error {
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if props.DestinationCidr == "" {
+		return errors.New("properties.destinationCidr is required")
+	}
+	if _, err := validation.CIDR(props.DestinationCidr); err != nil {
+		return fmt.Errorf("properties.destinationCidr: %w", err)
+	}
+	if props.RemoteSubnet == nil || props.RemoteSubnet.URI == "" {
+		return errors.New("properties.remoteSubnet.uri is required")
+	}
+	return nil
+}
@@ -0,0 +1,331 @@
+package vpcpeering
+
+type VpcPeeringDto struct {
+	Metadata   *MetadataDto             `json:"metadata,omitempty"`
+	Properties *VpcPeeringPropertiesDto `json:"properties,omitempty"`
+}
+
+// VpcPeeringUpdateDto only carries the metadata, the properties of a VPC peering cannot be updated
+type VpcPeeringUpdateDto struct {
+	Metadata *MetadataDto `json:"metadata,omitempty"`
+}
+
+type VpcPeeringRouteDto struct {
+	Metadata   *MetadataDto                  `json:"metadata,omitempty"`
+	Properties *VpcPeeringRoutePropertiesDto `json:"properties,omitempty"`
+}
+
+type MetadataDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+}
+
+type LocationDto struct {
+	// Value is the region where the resource will be located.
+	// Available regions at present: ITBG-Bergamo.
+	Value string `json:"value,omitempty"`
+}
+
+type VpcPeeringPropertiesDto struct {
+	// RemoteVpc is the VPC peered with the local one, which is the VPC of the request path,
+	// e.g. /projects/<PROJECT_ID>/providers/Aruba.Network/vpcs/<VPC_ID>.
+	RemoteVpc *ReferenceDto `json:"remoteVpc,omitempty"`
+}
+
+type VpcPeeringRoutePropertiesDto struct {
+	// DestinationCidr is the network routed to the remote VPC, e.g. 10.20.0.0/24.
+	// It must not overlap the subnets of the local VPC.
+	DestinationCidr string `json:"destinationCidr,omitempty"`
+	// RemoteSubnet is the subnet of the remote VPC the destination belongs to,
+	// e.g. /projects/<PROJECT_ID>/providers/Aruba.Network/vpcs/<VPC_ID>/subnets/<SUBNET_ID>.
+	RemoteSubnet *ReferenceDto `json:"remoteSubnet,omitempty"`
+}
+
+type ReferenceDto struct {
+	// URI is the URI of the referenced resource,
+	// e.g. /projects/<PROJECT_ID>/providers/Aruba.Network/vpcs/<VPC_ID>.
+	URI string `json:"uri,omitempty"`
+}
+
+type VpcPeeringResponseDto struct {
+	Metadata   *MetadataResponseDto             `json:"metadata,omitempty"`
+	Status     *StatusResponseDto               `json:"status,omitempty"`
+	Properties *VpcPeeringPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type VpcPeeringRouteResponseDto struct {
+	Metadata   *MetadataResponseDto                  `json:"metadata,omitempty"`
+	Status     *StatusResponseDto                    `json:"status,omitempty"`
+	Properties *VpcPeeringRoutePropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type MetadataResponseDto struct {
+	ID           string               `json:"id,omitempty"`
+	URI          string               `json:"uri,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	Location     *LocationResponseDto `json:"location,omitempty"`
+	Project      *ProjectResponseDto  `json:"project,omitempty"`
+	Tags         []string             `json:"tags,omitempty"`
+	Category     *CategoryResponseDto `json:"category,omitempty"`
+	CreationDate string               `json:"creationDate,omitempty"`
+	CreatedBy    string               `json:"createdBy,omitempty"`
+	UpdateDate   string               `json:"updateDate,omitempty"`
+	UpdatedBy    string               `json:"updatedBy,omitempty"`
+	Version      string               `json:"version,omitempty"`
+	CreatedUser  string               `json:"createdUser,omitempty"`
+	UpdatedUser  string               `json:"updatedUser,omitempty"`
+}
+
+type LocationResponseDto struct {
+	// Code is the code of the region.
+	Code string `json:"code,omitempty"`
+	// Country is the country of the region.
+	Country string `json:"country,omitempty"`
+	// City is the city of the region.
+	City string `json:"city,omitempty"`
+	// Name is the name of the region.
+	Name string `json:"name,omitempty"`
+	// Value is the value of the region.
+	Value string `json:"value,omitempty"`
+}
+
+type ProjectResponseDto struct {
+	// ID is the unique identifier of the project.
+	ID string `json:"id,omitempty"`
+}
+
+type CategoryResponseDto struct {
+	// Name is the name of the category.
+	Name string `json:"name,omitempty"`
+	// Provider is the provider of the category.
+	Provider string `json:"provider,omitempty"`
+	// Typology is the typology of the category.
+	Typology *TypologyResponseDto `json:"typology,omitempty"`
+}
+
+type TypologyResponseDto struct {
+	// ID is the unique identifier of the typology.
+	ID string `json:"id,omitempty"`
+	// Name is the name of the typology.
+	Name string `json:"name,omitempty"`
+}
+
+type StatusResponseDto struct {
+	// State is the state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the status.
+	CreationDate string `json:"creationDate,omitempty"`
+	// DisableStatusInfo contains the information about the disabled status of the resource.
+	DisableStatusInfo *DisableStatusInfoResponseDto `json:"disableStatusInfo,omitempty"`
+	// FailureReason is the reason of the failure, if any.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+type DisableStatusInfoResponseDto struct {
+	// IsDisabled indicates if the resource is disabled.
+	IsDisabled bool `json:"isDisabled,omitempty"`
+	// Reasons is a list of reasons for the disabled status.
+	Reasons []string `json:"reasons,omitempty"`
+	// PreviousStatus is the previous status of the resource.
+	PreviousStatus *PreviousStatusResponseDto `json:"previousStatus,omitempty"`
+}
+
+type PreviousStatusResponseDto struct {
+	// State is the previous state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the previous status.
+	CreationDate string `json:"creationDate,omitempty"`
+}
+
+type VpcPeeringPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the peering, e.g. its VPCs.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// LocalVpc is the VPC the peering belongs to.
+	LocalVpc *ReferenceDto `json:"localVpc,omitempty"`
+	// RemoteVpc is the VPC peered with the local one.
+	RemoteVpc *ReferenceDto `json:"remoteVpc,omitempty"`
+	// AcceptState is the accept state of the peering, e.g. Pending, Accepted or Rejected.
+	AcceptState string `json:"acceptState,omitempty"`
+}
+
+type VpcPeeringRoutePropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the route, e.g. its remote subnet.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// DestinationCidr is the network routed to the remote VPC.
+	DestinationCidr string `json:"destinationCidr,omitempty"`
+	// RemoteSubnet is the subnet of the remote VPC the destination belongs to.
+	RemoteSubnet *ReferenceDto `json:"remoteSubnet,omitempty"`
+}
+
+type LinkedResourceResponseDto struct {
+	// URI is the URI of the linked resource.
+	URI string `json:"uri,omitempty"`
+	// StrictCorrelation indicates if the correlation is strict.
+	StrictCorrelation bool `json:"strictCorrelation,omitempty"`
+}
+
+// --------------------------------------------------------------------------
+// Flattened types
+// --------------------------------------------------------------------------
+
+// FlattenedCreateVpcPeeringRequestDto is the flattened request body for creating a VPC peering.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateVpcPeeringRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the VPC peering.
+	Properties *VpcPeeringPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateVpcPeeringRequestDto is the flattened request body for updating a VPC peering.
+// The fields from MetadataDto are at the root level, the properties cannot be updated.
+type FlattenedUpdateVpcPeeringRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+}
+
+// FlattenedVpcPeeringResponseDto is the flattened response body for a single VPC peering.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedVpcPeeringResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the VPC peering.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the VPC peering.
+	Properties *VpcPeeringPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedVpcPeeringListResponseDto struct {
+	// Total is the total number of VPC peerings.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened VPC peerings.
+	Values []FlattenedVpcPeeringResponseDto `json:"values,omitempty"`
+}
+
+// FlattenedCreateVpcPeeringRouteRequestDto is the flattened request body for creating a VPC peering route.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateVpcPeeringRouteRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the VPC peering route.
+	Properties *VpcPeeringRoutePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateVpcPeeringRouteRequestDto is the flattened request body for updating a VPC peering route.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateVpcPeeringRouteRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the VPC peering route.
+	Properties *VpcPeeringRoutePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedVpcPeeringRouteResponseDto is the flattened response body for a single VPC peering route.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedVpcPeeringRouteResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the VPC peering route.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the VPC peering route.
+	Properties *VpcPeeringRoutePropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedVpcPeeringRouteListResponseDto struct {
+	// Total is the total number of VPC peering routes.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened VPC peering routes.
+	Values []FlattenedVpcPeeringRouteResponseDto `json:"values,omitempty"`
+}
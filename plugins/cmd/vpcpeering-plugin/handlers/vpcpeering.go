@@ -0,0 +1,202 @@
+package vpcpeering
+
+import (
+	"errors"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+)
+
+// VpcPeering is the Aruba Cloud peering resource connecting a VPC, the local one, to a remote VPC
+var VpcPeering = handlers.Resource{
+	Name:   "VPC peering",
+	Plural: "VPC peerings",
+	Path:   "/projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings",
+	PathParams: []handlers.Param{
+		handlers.ProjectIDParam,
+		{Name: "vpcId", Label: "VPC ID"},
+	},
+	IDParam:       handlers.Param{Name: "id", Label: "VPC Peering ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetVpcPeering
+// @Summary Get a VPC peering from Aruba Cloud
+// @Description Get a VPC peering from Aruba Cloud using the provided project, VPC and VPC peering details.
+// @Description The accept state of the peering is reported in properties.acceptState: a peering with a VPC of another project is Pending until it is accepted on the remote side.
+// @ID get-vpc-peering
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param id path string true "VPC Peering ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpcPeeringResponseDto "VPC peering details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings/{id} [get]
+func GetVpcPeering(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[VpcPeeringResponseDto](opts, VpcPeering)
+}
+
+// PostVpcPeering
+// @Summary Create a new VPC peering on Aruba Cloud
+// @Description Create a new VPC peering on Aruba Cloud using the provided project and VPC details.
+// @ID post-vpc-peering
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param vpcPeeringCreate body FlattenedCreateVpcPeeringRequestDto true "VPC peering creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedVpcPeeringResponseDto "VPC peering details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings [post]
+func PostVpcPeering(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateVpcPeeringRequestDto, VpcPeeringDto, VpcPeeringResponseDto](opts, VpcPeering,
+		func(req FlattenedCreateVpcPeeringRequestDto) VpcPeeringDto {
+			return VpcPeeringDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutVpcPeering
+// @Summary Update a VPC peering on Aruba Cloud
+// @Description Update a VPC peering on Aruba Cloud using the provided project, VPC and VPC peering details.
+// @Description Only the name, location and tags of a VPC peering can be updated, its VPCs are fixed at creation.
+// @ID put-vpc-peering
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param id path string true "VPC Peering ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param vpcPeeringUpdate body FlattenedUpdateVpcPeeringRequestDto true "VPC peering update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpcPeeringResponseDto "VPC peering details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings/{id} [put]
+func PutVpcPeering(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateVpcPeeringRequestDto, VpcPeeringUpdateDto, VpcPeeringResponseDto](opts, VpcPeering,
+		func(req FlattenedUpdateVpcPeeringRequestDto) VpcPeeringUpdateDto {
+			return VpcPeeringUpdateDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+			}
+		})
+}
+
+// ListVpcPeerings
+// @Summary List VPC peerings on Aruba Cloud
+// @Description List VPC peerings on Aruba Cloud using the provided project and VPC details.
+// @ID list-vpc-peerings
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpcPeeringListResponseDto "A list of VPC peerings"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings [get]
+func ListVpcPeerings(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[VpcPeeringResponseDto, FlattenedVpcPeeringResponseDto](opts, VpcPeering)
+}
+
+// DeleteVpcPeering
+// @Summary Delete a VPC peering on Aruba Cloud
+// @Description Delete a VPC peering on Aruba Cloud using the provided project, VPC and VPC peering details.
+// @Description Deleting a VPC peering that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-vpc-peering
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param id path string true "VPC Peering ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings/{id} [delete]
+func DeleteVpcPeering(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, VpcPeering)
+}
+
+// AcceptVpcPeering
+// @Summary Accept a VPC peering on Aruba Cloud
+// @Description Accept a VPC peering on Aruba Cloud using the provided project, VPC and VPC peering details.
+// @Description A peering with a VPC of another project stays in the Pending accept state until it is accepted on the remote side,
+// @Description the accept state is reported in properties.acceptState.
+// @ID accept-vpc-peering
+// @Param projectId path string true "Project ID"
+// @Param vpcId path string true "VPC ID"
+// @Param id path string true "VPC Peering ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpcPeeringResponseDto "VPC peering details"
+// @Success 202 "Accepted"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 409 {object} handlers.ProblemDetails "Conflict"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/vpcPeerings/{id}/accept [post]
+func AcceptVpcPeering(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Action[VpcPeeringResponseDto](opts, VpcPeering, "accept", "accept")
+}
+
+// Accept states of a VPC peering
+const (
+	AcceptStatePending  = "Pending"
+	AcceptStateAccepted = "Accepted"
+	AcceptStateRejected = "Rejected"
+)
+
+// Validate checks the remote VPC of the peering before it is sent to Aruba Cloud
+func (req FlattenedCreateVpcPeeringRequestDto) Validate() error {
+	props := req.Properties
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if props.RemoteVpc == nil || props.RemoteVpc.URI == "" {
+		return errors.New("properties.remoteVpc.uri is required")
+	}
+	return nil
+}
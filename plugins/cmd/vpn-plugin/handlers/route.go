@@ -0,0 +1,194 @@
+package vpn
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// VpnRoute is the Aruba Cloud route resource of a VPN tunnel, sending the traffic between a network of the VPC and an on-premises network
+var VpnRoute = handlers.Resource{
+	Name:   "VPN route",
+	Plural: "VPN routes",
+	Path:   "/projects/{projectId}/providers/Aruba.Network/vpnTunnels/{vpnTunnelId}/vpnRoutes",
+	PathParams: []handlers.Param{
+		handlers.ProjectIDParam,
+		{Name: "vpnTunnelId", Label: "VPN Tunnel ID"},
+	},
+	IDParam:       handlers.Param{Name: "id", Label: "VPN Route ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetVpnRoute
+// @Summary Get a VPN route from Aruba Cloud
+// @Description Get a VPN route from Aruba Cloud using the provided project, VPN tunnel and VPN route details.
+// @ID get-vpn-route
+// @Param projectId path string true "Project ID"
+// @Param vpnTunnelId path string true "VPN Tunnel ID"
+// @Param id path string true "VPN Route ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpnRouteResponseDto "VPN route details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpnTunnels/{vpnTunnelId}/vpnRoutes/{id} [get]
+func GetVpnRoute(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[VpnRouteResponseDto](opts, VpnRoute)
+}
+
+// PostVpnRoute
+// @Summary Create a new VPN route on Aruba Cloud
+// @Description Create a new VPN route on Aruba Cloud using the provided project and VPN tunnel details.
+// @ID post-vpn-route
+// @Param projectId path string true "Project ID"
+// @Param vpnTunnelId path string true "VPN Tunnel ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param vpnRouteCreate body FlattenedCreateVpnRouteRequestDto true "VPN route creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedVpnRouteResponseDto "VPN route details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpnTunnels/{vpnTunnelId}/vpnRoutes [post]
+func PostVpnRoute(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateVpnRouteRequestDto, VpnRouteDto, VpnRouteResponseDto](opts, VpnRoute,
+		func(req FlattenedCreateVpnRouteRequestDto) VpnRouteDto {
+			return VpnRouteDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// PutVpnRoute
+// @Summary Update a VPN route on Aruba Cloud
+// @Description Update a VPN route on Aruba Cloud using the provided project, VPN tunnel and VPN route details.
+// @ID put-vpn-route
+// @Param projectId path string true "Project ID"
+// @Param vpnTunnelId path string true "VPN Tunnel ID"
+// @Param id path string true "VPN Route ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param vpnRouteUpdate body FlattenedUpdateVpnRouteRequestDto true "VPN route update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpnRouteResponseDto "VPN route details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpnTunnels/{vpnTunnelId}/vpnRoutes/{id} [put]
+func PutVpnRoute(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateVpnRouteRequestDto, VpnRouteDto, VpnRouteResponseDto](opts, VpnRoute,
+		func(req FlattenedUpdateVpnRouteRequestDto) VpnRouteDto {
+			return VpnRouteDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: req.Properties,
+			}
+		})
+}
+
+// ListVpnRoutes
+// @Summary List VPN routes on Aruba Cloud
+// @Description List VPN routes on Aruba Cloud using the provided project and VPN tunnel details.
+// @ID list-vpn-routes
+// @Param projectId path string true "Project ID"
+// @Param vpnTunnelId path string true "VPN Tunnel ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpnRouteListResponseDto "A list of VPN routes"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpnTunnels/{vpnTunnelId}/vpnRoutes [get]
+func ListVpnRoutes(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[VpnRouteResponseDto, FlattenedVpnRouteResponseDto](opts, VpnRoute)
+}
+
+// DeleteVpnRoute
+// @Summary Delete a VPN route on Aruba Cloud
+// @Description Delete a VPN route on Aruba Cloud using the provided project, VPN tunnel and VPN route details.
+// @Description Deleting a VPN route that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-vpn-route
+// @Param projectId path string true "Project ID"
+// @Param vpnTunnelId path string true "VPN Tunnel ID"
+// @Param id path string true "VPN Route ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpnTunnels/{vpnTunnelId}/vpnRoutes/{id} [delete]
+func DeleteVpnRoute(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, VpnRoute)
+}
+
+// Validate checks the networks of the VPN route before it is sent to Aruba Cloud
+func (req FlattenedCreateVpnRouteRequestDto) Validate() error {
+	return validateRoute(req.Properties)
+}
+
+// Validate checks the networks of the VPN route before it is sent to Aruba Cloud
+func (req FlattenedUpdateVpnRouteRequestDto) Validate() error {
+	return validateRoute(req.Properties)
+}
+
+// validateRoute checks that both networks of the route are valid and do not overlap,
+// otherwise the traffic of the on-premises network would never leave the VPC
+func validateRoute(props *VpnRoutePropertiesDto) error {
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if props.CloudSubnet == "" {
+		return errors.New("properties.cloudSubnet is required")
+	}
+	cloud, err := validation.CIDR(props.CloudSubnet)
+	if err != nil {
+		return fmt.Errorf("properties.cloudSubnet: %w", err)
+	}
+	if props.OnPremSubnet == "" {
+		return errors.New("properties.onPremSubnet is required")
+	}
+	onPrem, err := validation.CIDR(props.OnPremSubnet)
+	if err != nil {
+		return fmt.Errorf("properties.onPremSubnet: %w", err)
+	}
+	if cloud.Overlaps(onPrem) {
+		return fmt.Errorf("properties.onPremSubnet %s must not overlap properties.cloudSubnet %s", onPrem, cloud)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package vpn
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+)
+
+// TestVpnRouteHandlers tests the unflattening of the VPN routes sent to Aruba Cloud
+func TestVpnRouteHandlers(t *testing.T) {
+	const routesURI = "/projects/p1/providers/Aruba.Network/vpnTunnels/t1/vpnRoutes"
+	route := `{"metadata":{"id":"r1","name":"office"},"status":{"state":"Active"},"properties":{"cloudSubnet":"10.0.1.0/24","onPremSubnet":"192.168.0.0/16"}}`
+	flattened := `{"id":"r1","name":"office","properties":{"cloudSubnet":"10.0.1.0/24","onPremSubnet":"192.168.0.0/16"},"status":{"state":"Active"}}`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		upstreamStatus int
+		upstreamBody   string
+		expectedCall   handlertest.Call
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "create",
+			method:         http.MethodPost,
+			target:         routesURI + "?api-version=1.0",
+			body:           `{"name":"office","properties":{"cloudSubnet":"10.0.1.0/24","onPremSubnet":"192.168.0.0/16"}}`,
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   route,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: routesURI + "?api-version=1.0", Body: `{"metadata":{"name":"office"},"properties":{"cloudSubnet":"10.0.1.0/24","onPremSubnet":"192.168.0.0/16"}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "adjacent networks",
+			method:         http.MethodPut,
+			target:         routesURI + "/r1?api-version=1.0",
+			body:           `{"name":"office","properties":{"cloudSubnet":"10.0.1.0/24","onPremSubnet":"10.0.2.0/24"}}`,
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   route,
+			expectedCall:   handlertest.Call{Method: http.MethodPut, URI: routesURI + "/r1?api-version=1.0", Body: `{"metadata":{"name":"office"},"properties":{"cloudSubnet":"10.0.1.0/24","onPremSubnet":"10.0.2.0/24"}}`},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.upstreamStatus)
+				w.Write([]byte(tc.upstreamBody))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 1 || (*calls)[0] != tc.expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", tc.expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tc.expectedBody {
+				t.Errorf("expected body '%s', got '%s'", tc.expectedBody, got)
+			}
+		})
+	}
+}
+
+// TestVpnRouteHandlers_Validation tests that the routes with invalid or overlapping networks are rejected before calling Aruba Cloud
+func TestVpnRouteHandlers_Validation(t *testing.T) {
+	const routesURI = "/projects/p1/providers/Aruba.Network/vpnTunnels/t1/vpnRoutes?api-version=1.0"
+	const routeURI = "/projects/p1/providers/Aruba.Network/vpnTunnels/t1/vpnRoutes/r1?api-version=1.0"
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedDetail string
+	}{
+		{name: "missing cloud subnet", method: http.MethodPost, target: routesURI, body: `{"properties":{"onPremSubnet":"192.168.0.0/16"}}`, expectedDetail: "properties.cloudSubnet is required"},
+		{name: "invalid on-premises subnet", method: http.MethodPost, target: routesURI, body: `{"properties":{"cloudSubnet":"10.0.1.0/24","onPremSubnet":"192.168.0.0/33"}}`, expectedDetail: "properties.onPremSubnet: invalid CIDR '192.168.0.0/33'"},
+		{name: "same networks", method: http.MethodPost, target: routesURI, body: `{"properties":{"cloudSubnet":"10.0.1.0/24","onPremSubnet":"10.0.1.0/24"}}`, expectedDetail: "properties.onPremSubnet 10.0.1.0/24 must not overlap properties.cloudSubnet 10.0.1.0/24"},
+		{name: "on-premises network containing the cloud subnet", method: http.MethodPost, target: routesURI, body: `{"properties":{"cloudSubnet":"10.0.1.0/24","onPremSubnet":"10.0.0.0/8"}}`, expectedDetail: "properties.onPremSubnet 10.0.0.0/8 must not overlap properties.cloudSubnet 10.0.1.0/24"},
+		{name: "cloud subnet containing the on-premises address", method: http.MethodPut, target: routeURI, body: `{"properties":{"cloudSubnet":"10.0.0.0/16","onPremSubnet":"10.0.5.1"}}`, expectedDetail: "properties.onPremSubnet 10.0.5.1/32 must not overlap properties.cloudSubnet 10.0.0.0/16"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 0 {
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			}
+			if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+				t.Fatalf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+			}
+			var problem handlers.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
@@ -0,0 +1,345 @@
+package vpn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/secrets"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/validation"
+)
+
+// VpnTunnel is the Aruba Cloud site-to-site IPsec VPN tunnel resource, connecting a VPC to an on-premises gateway
+var VpnTunnel = handlers.Resource{
+	Name:          "VPN tunnel",
+	Plural:        "VPN tunnels",
+	Path:          "/projects/{projectId}/providers/Aruba.Network/vpnTunnels",
+	PathParams:    []handlers.Param{handlers.ProjectIDParam},
+	IDParam:       handlers.Param{Name: "id", Label: "VPN Tunnel ID"},
+	QueryParams:   []handlers.Param{handlers.APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// GetVpnTunnel
+// @Summary Get a VPN tunnel from Aruba Cloud
+// @Description Get a VPN tunnel from Aruba Cloud using the provided project and VPN tunnel details.
+// @Description The state of the tunnel is reported in status.state and its IPsec connection in properties.connectionState, e.g. Up or Down.
+// @Description The pre-shared key of the tunnel is never part of the response.
+// @ID get-vpn-tunnel
+// @Param projectId path string true "Project ID"
+// @Param id path string true "VPN Tunnel ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param ignoreDeletedStatus query boolean false "if the resource exists in status 'Deleted', returns NotFound according to the value of this flag"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpnTunnelResponseDto "VPN tunnel details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpnTunnels/{id} [get]
+func GetVpnTunnel(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Get[VpnTunnelResponseDto](opts, VpnTunnel)
+}
+
+// PostVpnTunnel
+// @Summary Create a new VPN tunnel on Aruba Cloud
+// @Description Create a new VPN tunnel on Aruba Cloud using the provided project details.
+// @Description The pre-shared key of the tunnel is read from the Kubernetes Secret referenced by properties.preSharedKeySecretRef.
+// @ID post-vpn-tunnel
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param vpnTunnelCreate body FlattenedCreateVpnTunnelRequestDto true "VPN tunnel creation request body"
+// @Accept json
+// @Produce json
+// @Success 201 {object} FlattenedVpnTunnelResponseDto "VPN tunnel details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpnTunnels [post]
+func PostVpnTunnel(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Create[FlattenedCreateVpnTunnelRequestDto, VpnTunnelDto, VpnTunnelResponseDto](opts, VpnTunnel,
+		func(req FlattenedCreateVpnTunnelRequestDto) VpnTunnelDto {
+			var props *VpnTunnelPropertiesDto
+			if p := req.Properties; p != nil {
+				props = &VpnTunnelPropertiesDto{
+					Vpc:          p.Vpc,
+					ElasticIp:    p.ElasticIp,
+					PeerAddress:  p.PeerAddress,
+					Ike:          p.Ike,
+					Ipsec:        p.Ipsec,
+					PreSharedKey: p.preSharedKey,
+				}
+			}
+			return VpnTunnelDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: props,
+			}
+		})
+}
+
+// PutVpnTunnel
+// @Summary Update a VPN tunnel on Aruba Cloud
+// @Description Update a VPN tunnel on Aruba Cloud using the provided project and VPN tunnel details.
+// @Description The pre-shared key is changed when properties.preSharedKeySecretRef is set. The VPC and the Elastic IP of a tunnel are fixed at creation.
+// @ID put-vpn-tunnel
+// @Param projectId path string true "Project ID"
+// @Param id path string true "VPN Tunnel ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Param vpnTunnelUpdate body FlattenedUpdateVpnTunnelRequestDto true "VPN tunnel update request body"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpnTunnelResponseDto "VPN tunnel details"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 404 {object} handlers.ProblemDetails "Not Found"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpnTunnels/{id} [put]
+func PutVpnTunnel(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Update[FlattenedUpdateVpnTunnelRequestDto, VpnTunnelUpdateDto, VpnTunnelResponseDto](opts, VpnTunnel,
+		func(req FlattenedUpdateVpnTunnelRequestDto) VpnTunnelUpdateDto {
+			var props *VpnTunnelUpdatePropertiesDto
+			if p := req.Properties; p != nil {
+				props = &VpnTunnelUpdatePropertiesDto{
+					PeerAddress:  p.PeerAddress,
+					Ike:          p.Ike,
+					Ipsec:        p.Ipsec,
+					PreSharedKey: p.preSharedKey,
+				}
+			}
+			return VpnTunnelUpdateDto{
+				Metadata: &MetadataDto{
+					Name:     req.Name,
+					Location: req.Location,
+					Tags:     req.Tags,
+				},
+				Properties: props,
+			}
+		})
+}
+
+// ListVpnTunnels
+// @Summary List VPN tunnels on Aruba Cloud
+// @Description List VPN tunnels on Aruba Cloud using the provided project details.
+// @ID list-vpn-tunnels
+// @Param projectId path string true "Project ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param filter query string false "Filter expression"
+// @Param sort query string false "Sort expression"
+// @Param projection query string false "Projection expression"
+// @Param offset query integer false "Offset for pagination"
+// @Param limit query integer false "Limit for pagination"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 200 {object} FlattenedVpnTunnelListResponseDto "A list of VPN tunnels"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpnTunnels [get]
+func ListVpnTunnels(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.List[VpnTunnelResponseDto, FlattenedVpnTunnelResponseDto](opts, VpnTunnel)
+}
+
+// DeleteVpnTunnel
+// @Summary Delete a VPN tunnel on Aruba Cloud
+// @Description Delete a VPN tunnel on Aruba Cloud using the provided project and VPN tunnel details.
+// @Description Deleting a VPN tunnel that does not exist or is already in 'Deleted' state is considered successful.
+// @ID delete-vpn-tunnel
+// @Param projectId path string true "Project ID"
+// @Param id path string true "VPN Tunnel ID"
+// @Param api-version query string true "API version (e.g., 1.0)"
+// @Param Authorization header string false "Bearer Token (Bearer <token>), optional when the plugin is configured with client credentials"
+// @Accept json
+// @Produce json
+// @Success 202 "Accepted"
+// @Success 204 "No Content"
+// @Failure 400 {object} handlers.ProblemDetails "Bad Request"
+// @Failure 401 {object} handlers.ProblemDetails "Unauthorized"
+// @Failure 500 {object} handlers.ProblemDetails "Internal Server Error"
+// @Failure 504 {object} handlers.ProblemDetails "Gateway Timeout"
+// @Router /projects/{projectId}/providers/Aruba.Network/vpnTunnels/{id} [delete]
+func DeleteVpnTunnel(opts handlers.HandlerOptions) handlers.Handler {
+	return handlers.Delete(opts, VpnTunnel)
+}
+
+// IKE versions, algorithms and Diffie-Hellman groups accepted by the VPN tunnels
+const (
+	IkeV1 = "IKEv1"
+	IkeV2 = "IKEv2"
+
+	EncryptionAES128 = "AES128"
+	EncryptionAES192 = "AES192"
+	EncryptionAES256 = "AES256"
+
+	IntegritySHA1   = "SHA1"
+	IntegritySHA256 = "SHA256"
+	IntegritySHA384 = "SHA384"
+	IntegritySHA512 = "SHA512"
+)
+
+// dhGroups are the Diffie-Hellman groups of the key exchanges, the weaker groups 1, 2 and 5 are refused
+var dhGroups = []int32{14, 15, 16, 19, 20, 21}
+
+// Validate checks the addresses, the IKE and IPsec parameters and the pre-shared key reference of the VPN tunnel
+// before it is sent to Aruba Cloud
+func (req FlattenedCreateVpnTunnelRequestDto) Validate() error {
+	props := req.Properties
+	if props == nil {
+		return errors.New("properties are required")
+	}
+	if props.Vpc == nil || props.Vpc.URI == "" {
+		return errors.New("properties.vpc.uri is required")
+	}
+	if props.ElasticIp == nil || props.ElasticIp.URI == "" {
+		return errors.New("properties.elasticIp.uri is required")
+	}
+	if err := validatePeerAddress(props.PeerAddress); err != nil {
+		return err
+	}
+	if props.Ike == nil {
+		return errors.New("properties.ike is required")
+	}
+	if props.Ipsec == nil {
+		return errors.New("properties.ipsec is required")
+	}
+	if err := validateIke(props.Ike); err != nil {
+		return err
+	}
+	if err := validateIpsec(props.Ipsec); err != nil {
+		return err
+	}
+	return props.PreSharedKeySecretRef.Validate("properties.preSharedKeySecretRef")
+}
+
+// ResolveSecrets reads the pre-shared key of the VPN tunnel from its Secret
+func (req *FlattenedCreateVpnTunnelRequestDto) ResolveSecrets(ctx context.Context, resolver secrets.Resolver) error {
+	key, err := resolvePreSharedKey(ctx, resolver, *req.Properties.PreSharedKeySecretRef)
+	if err != nil {
+		return err
+	}
+	req.Properties.preSharedKey = key
+	return nil
+}
+
+// Validate checks the changed parameters of the VPN tunnel before they are sent to Aruba Cloud
+func (req FlattenedUpdateVpnTunnelRequestDto) Validate() error {
+	props := req.Properties
+	if props == nil {
+		return nil
+	}
+	if props.PeerAddress != "" {
+		if err := validatePeerAddress(props.PeerAddress); err != nil {
+			return err
+		}
+	}
+	if props.Ike != nil {
+		if err := validateIke(props.Ike); err != nil {
+			return err
+		}
+	}
+	if props.Ipsec != nil {
+		if err := validateIpsec(props.Ipsec); err != nil {
+			return err
+		}
+	}
+	if props.PreSharedKeySecretRef != nil {
+		return props.PreSharedKeySecretRef.Validate("properties.preSharedKeySecretRef")
+	}
+	return nil
+}
+
+// ResolveSecrets reads the new pre-shared key of the VPN tunnel from its Secret, if referenced
+func (req *FlattenedUpdateVpnTunnelRequestDto) ResolveSecrets(ctx context.Context, resolver secrets.Resolver) error {
+	if req.Properties == nil || req.Properties.PreSharedKeySecretRef == nil {
+		return nil
+	}
+	key, err := resolvePreSharedKey(ctx, resolver, *req.Properties.PreSharedKeySecretRef)
+	if err != nil {
+		return err
+	}
+	req.Properties.preSharedKey = key
+	return nil
+}
+
+// resolvePreSharedKey reads the pre-shared key referenced by ref, an empty key is reported as missing.
+// The key is never part of the errors.
+func resolvePreSharedKey(ctx context.Context, resolver secrets.Resolver, ref secrets.Ref) (string, error) {
+	key, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	if key == "" {
+		return "", fmt.Errorf("%s is empty: %w", ref, secrets.ErrNotFound)
+	}
+	return key, nil
+}
+
+// validatePeerAddress checks that the peer is a public IP address, the private, loopback, link-local,
+// unspecified and multicast addresses are not reachable by Aruba Cloud over the internet
+func validatePeerAddress(address string) error {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return fmt.Errorf("properties.peerAddress must be the public IP address of the on-premises gateway, got '%s'", address)
+	}
+	addr = addr.Unmap()
+	if addr.IsPrivate() || addr.IsLoopback() || addr.IsLinkLocalUnicast() || addr.IsUnspecified() || addr.IsMulticast() {
+		return fmt.Errorf("properties.peerAddress must be the public IP address of the on-premises gateway, got the non-public address '%s'", address)
+	}
+	return nil
+}
+
+func validateIke(ike *IkeDto) error {
+	if err := validation.OneOf("properties.ike.version", ike.Version, IkeV1, IkeV2); err != nil {
+		return err
+	}
+	if err := validateAlgorithms("properties.ike", ike.Encryption, ike.Integrity, ike.LifetimeSeconds); err != nil {
+		return err
+	}
+	return validateDhGroup("properties.ike.dhGroup", ike.DhGroup)
+}
+
+func validateIpsec(ipsec *IpsecDto) error {
+	if err := validateAlgorithms("properties.ipsec", ipsec.Encryption, ipsec.Integrity, ipsec.LifetimeSeconds); err != nil {
+		return err
+	}
+	// Perfect forward secrecy is disabled without a group
+	if ipsec.PfsGroup == 0 {
+		return nil
+	}
+	return validateDhGroup("properties.ipsec.pfsGroup", ipsec.PfsGroup)
+}
+
+func validateAlgorithms(field, encryption, integrity string, lifetimeSeconds int32) error {
+	if err := validation.OneOf(field+".encryption", encryption, EncryptionAES128, EncryptionAES192, EncryptionAES256); err != nil {
+		return err
+	}
+	if err := validation.OneOf(field+".integrity", integrity, IntegritySHA1, IntegritySHA256, IntegritySHA384, IntegritySHA512); err != nil {
+		return err
+	}
+	if lifetimeSeconds < 0 {
+		return fmt.Errorf("%s.lifetimeSeconds must not be negative, got %d", field, lifetimeSeconds)
+	}
+	return nil
+}
+
+func validateDhGroup(field string, group int32) error {
+	if !slices.Contains(dhGroups, group) {
+		return fmt.Errorf("%s must be one of %v, got %d", field, dhGroups, group)
+	}
+	return nil
+}
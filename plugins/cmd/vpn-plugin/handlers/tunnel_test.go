@@ -0,0 +1,159 @@
+package vpn
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers/handlertest"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/secrets"
+)
+
+// newTestMux serves the VPN tunnel and route handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]handlertest.Call) {
+	t.Helper()
+	opts, calls := handlertest.NewOptions(t, respond)
+	opts.Secrets = testResolver{"default/psk key preSharedKey": "s3cret"}
+	mux := http.NewServeMux()
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Network/vpnTunnels", PostVpnTunnel(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Network/vpnTunnels/{id}", PutVpnTunnel(opts))
+	mux.Handle("POST /projects/{projectId}/providers/Aruba.Network/vpnTunnels/{vpnTunnelId}/vpnRoutes", PostVpnRoute(opts))
+	mux.Handle("PUT /projects/{projectId}/providers/Aruba.Network/vpnTunnels/{vpnTunnelId}/vpnRoutes/{id}", PutVpnRoute(opts))
+	return mux, calls
+}
+
+// TestVpnTunnelHandlers tests that the pre-shared key is read from its Secret and only sent to Aruba Cloud
+func TestVpnTunnelHandlers(t *testing.T) {
+	const tunnelsURI = "/projects/p1/providers/Aruba.Network/vpnTunnels"
+	tunnel := `{"metadata":{"id":"t1","name":"office"},"status":{"state":"Active"},"properties":{"peerAddress":"203.0.113.10","connectionState":"Up"}}`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		upstreamStatus int
+		upstreamBody   string
+		expectedCall   handlertest.Call
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "create",
+			method:         http.MethodPost,
+			target:         tunnelsURI + "?api-version=1.0",
+			body:           tunnelRequest("203.0.113.10", 14, 19),
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   tunnel,
+			expectedCall:   handlertest.Call{Method: http.MethodPost, URI: tunnelsURI + "?api-version=1.0", Body: `{"metadata":{"name":"office"},"properties":{"vpc":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1"},"elasticIp":{"uri":"/projects/p1/providers/Aruba.Network/elasticIps/eip1"},"peerAddress":"203.0.113.10","ike":{"version":"IKEv2","encryption":"AES256","integrity":"SHA256","dhGroup":14},"ipsec":{"encryption":"AES256","integrity":"SHA256","pfsGroup":19},"preSharedKey":"s3cret"}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   `{"id":"t1","name":"office","properties":{"connectionState":"Up","peerAddress":"203.0.113.10"},"status":{"state":"Active"}}`,
+		},
+		{
+			name:           "update of the peer address",
+			method:         http.MethodPut,
+			target:         tunnelsURI + "/t1?api-version=1.0",
+			body:           `{"name":"office","properties":{"peerAddress":"198.51.100.7"}}`,
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   tunnel,
+			expectedCall:   handlertest.Call{Method: http.MethodPut, URI: tunnelsURI + "/t1?api-version=1.0", Body: `{"metadata":{"name":"office"},"properties":{"peerAddress":"198.51.100.7"}}`},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"id":"t1","name":"office","properties":{"connectionState":"Up","peerAddress":"203.0.113.10"},"status":{"state":"Active"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.upstreamStatus)
+				w.Write([]byte(tc.upstreamBody))
+			})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 1 || (*calls)[0] != tc.expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", tc.expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tc.expectedBody {
+				t.Errorf("expected body '%s', got '%s'", tc.expectedBody, got)
+			}
+		})
+	}
+}
+
+// TestVpnTunnelHandlers_Validation tests that the tunnels with a non-public peer or weak Diffie-Hellman groups are rejected before calling Aruba Cloud
+func TestVpnTunnelHandlers_Validation(t *testing.T) {
+	const tunnelsURI = "/projects/p1/providers/Aruba.Network/vpnTunnels?api-version=1.0"
+	const tunnelURI = "/projects/p1/providers/Aruba.Network/vpnTunnels/t1?api-version=1.0"
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedDetail string
+	}{
+		{name: "invalid peer address", method: http.MethodPost, target: tunnelsURI, body: tunnelRequest("gateway.example.com", 14, 0), expectedDetail: "properties.peerAddress must be the public IP address of the on-premises gateway, got 'gateway.example.com'"},
+		{name: "private peer address", method: http.MethodPost, target: tunnelsURI, body: tunnelRequest("192.168.1.1", 14, 0), expectedDetail: "properties.peerAddress must be the public IP address of the on-premises gateway, got the non-public address '192.168.1.1'"},
+		{name: "loopback peer address", method: http.MethodPost, target: tunnelsURI, body: tunnelRequest("127.0.0.1", 14, 0), expectedDetail: "properties.peerAddress must be the public IP address of the on-premises gateway, got the non-public address '127.0.0.1'"},
+		{name: "link-local peer address", method: http.MethodPost, target: tunnelsURI, body: tunnelRequest("169.254.169.254", 14, 0), expectedDetail: "properties.peerAddress must be the public IP address of the on-premises gateway, got the non-public address '169.254.169.254'"},
+		{name: "unspecified peer address", method: http.MethodPost, target: tunnelsURI, body: tunnelRequest("0.0.0.0", 14, 0), expectedDetail: "properties.peerAddress must be the public IP address of the on-premises gateway, got the non-public address '0.0.0.0'"},
+		{name: "multicast peer address", method: http.MethodPost, target: tunnelsURI, body: tunnelRequest("224.0.0.5", 14, 0), expectedDetail: "properties.peerAddress must be the public IP address of the on-premises gateway, got the non-public address '224.0.0.5'"},
+		{name: "IPv4-mapped private peer address", method: http.MethodPost, target: tunnelsURI, body: tunnelRequest("::ffff:10.0.0.1", 14, 0), expectedDetail: "properties.peerAddress must be the public IP address of the on-premises gateway, got the non-public address '::ffff:10.0.0.1'"},
+		{name: "private IPv6 peer address on update", method: http.MethodPut, target: tunnelURI, body: `{"properties":{"peerAddress":"fd00::1"}}`, expectedDetail: "properties.peerAddress must be the public IP address of the on-premises gateway, got the non-public address 'fd00::1'"},
+		{name: "IKE DH group 1", method: http.MethodPost, target: tunnelsURI, body: tunnelRequest("203.0.113.10", 1, 0), expectedDetail: "properties.ike.dhGroup must be one of [14 15 16 19 20 21], got 1"},
+		{name: "IKE DH group 2", method: http.MethodPost, target: tunnelsURI, body: tunnelRequest("203.0.113.10", 2, 0), expectedDetail: "properties.ike.dhGroup must be one of [14 15 16 19 20 21], got 2"},
+		{name: "IKE DH group 5", method: http.MethodPost, target: tunnelsURI, body: tunnelRequest("203.0.113.10", 5, 0), expectedDetail: "properties.ike.dhGroup must be one of [14 15 16 19 20 21], got 5"},
+		{name: "IPsec PFS group 1", method: http.MethodPost, target: tunnelsURI, body: tunnelRequest("203.0.113.10", 14, 1), expectedDetail: "properties.ipsec.pfsGroup must be one of [14 15 16 19 20 21], got 1"},
+		{name: "IPsec PFS group 2", method: http.MethodPost, target: tunnelsURI, body: tunnelRequest("203.0.113.10", 14, 2), expectedDetail: "properties.ipsec.pfsGroup must be one of [14 15 16 19 20 21], got 2"},
+		{name: "IPsec PFS group 5 on update", method: http.MethodPut, target: tunnelURI, body: `{"properties":{"ipsec":{"encryption":"AES256","integrity":"SHA256","pfsGroup":5}}}`, expectedDetail: "properties.ipsec.pfsGroup must be one of [14 15 16 19 20 21], got 5"},
+		{name: "IKE DH group 5 on update", method: http.MethodPut, target: tunnelURI, body: `{"properties":{"ike":{"version":"IKEv2","encryption":"AES256","integrity":"SHA256","dhGroup":5}}}`, expectedDetail: "properties.ike.dhGroup must be one of [14 15 16 19 20 21], got 5"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+			rec := handlertest.Serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 0 {
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			}
+			if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != handlers.ProblemContentType {
+				t.Fatalf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+			}
+			var problem handlers.ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
+
+// testResolver serves the Secret values by reference
+type testResolver map[string]string
+
+func (r testResolver) Resolve(ctx context.Context, ref secrets.Ref) (string, error) {
+	value, ok := r[ref.String()]
+	if !ok {
+		return "", fmt.Errorf("%s %w", ref, secrets.ErrNotFound)
+	}
+	return value, nil
+}
+
+// tunnelRequest is the creation request of a VPN tunnel to peerAddress with the given IKE and PFS groups
+func tunnelRequest(peerAddress string, dhGroup, pfsGroup int32) string {
+	return fmt.Sprintf(`{"name":"office","properties":{"vpc":{"uri":"/projects/p1/providers/Aruba.Network/vpcs/vpc1"},"elasticIp":{"uri":"/projects/p1/providers/Aruba.Network/elasticIps/eip1"},"peerAddress":"%s",`+
+		`"ike":{"version":"IKEv2","encryption":"AES256","integrity":"SHA256","dhGroup":%d},"ipsec":{"encryption":"AES256","integrity":"SHA256","pfsGroup":%d},`+
+		`"preSharedKeySecretRef":{"name":"psk","namespace":"default","key":"preSharedKey"}}}`, peerAddress, dhGroup, pfsGroup)
+}
@@ -0,0 +1,418 @@
+package vpn
+
+import "github.com/krateoplatformops/arubacloud-provider-kog/pkg/secrets"
+
+type VpnRouteDto struct {
+	Metadata   *MetadataDto           `json:"metadata,omitempty"`
+	Properties *VpnRoutePropertiesDto `json:"properties,omitempty"`
+}
+
+type MetadataDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+}
+
+type LocationDto struct {
+	// Value is the region where the resource will be located.
+	// Available regions at present: ITBG-Bergamo.
+	Value string `json:"value,omitempty"`
+}
+
+type VpnTunnelDto struct {
+	Metadata   *MetadataDto            `json:"metadata,omitempty"`
+	Properties *VpnTunnelPropertiesDto `json:"properties,omitempty"`
+}
+
+type VpnTunnelUpdateDto struct {
+	Metadata   *MetadataDto                  `json:"metadata,omitempty"`
+	Properties *VpnTunnelUpdatePropertiesDto `json:"properties,omitempty"`
+}
+
+// VpnTunnelPropertiesDto are the properties sent to Aruba Cloud, with the pre-shared key read from the Secret
+type VpnTunnelPropertiesDto struct {
+	Vpc          *ReferenceDto `json:"vpc,omitempty"`
+	ElasticIp    *ReferenceDto `json:"elasticIp,omitempty"`
+	PeerAddress  string        `json:"peerAddress,omitempty"`
+	Ike          *IkeDto       `json:"ike,omitempty"`
+	Ipsec        *IpsecDto     `json:"ipsec,omitempty"`
+	PreSharedKey string        `json:"preSharedKey,omitempty"`
+}
+
+// VpnTunnelUpdatePropertiesDto are the properties of a VPN tunnel that can be changed
+type VpnTunnelUpdatePropertiesDto struct {
+	PeerAddress  string    `json:"peerAddress,omitempty"`
+	Ike          *IkeDto   `json:"ike,omitempty"`
+	Ipsec        *IpsecDto `json:"ipsec,omitempty"`
+	PreSharedKey string    `json:"preSharedKey,omitempty"`
+}
+
+// VpnTunnelRequestPropertiesDto are the properties of the flattened request,
+// where the pre-shared key of the tunnel is referenced by a Kubernetes Secret
+type VpnTunnelRequestPropertiesDto struct {
+	// Vpc is the VPC connected by the tunnel.
+	Vpc *ReferenceDto `json:"vpc,omitempty"`
+	// ElasticIp is the Elastic IP the tunnel is established from on the Aruba Cloud side.
+	ElasticIp *ReferenceDto `json:"elasticIp,omitempty"`
+	// PeerAddress is the public IP address of the on-premises gateway.
+	PeerAddress string `json:"peerAddress,omitempty"`
+	// Ike contains the parameters of the key exchange (phase 1).
+	Ike *IkeDto `json:"ike,omitempty"`
+	// Ipsec contains the parameters of the IPsec security associations (phase 2).
+	Ipsec *IpsecDto `json:"ipsec,omitempty"`
+	// PreSharedKeySecretRef is the key of the Kubernetes Secret holding the pre-shared key of the tunnel.
+	PreSharedKeySecretRef *secrets.Ref `json:"preSharedKeySecretRef,omitempty"`
+
+	preSharedKey string
+}
+
+// VpnTunnelUpdateRequestPropertiesDto are the properties of the flattened update request,
+// the pre-shared key is changed when a Secret is referenced
+type VpnTunnelUpdateRequestPropertiesDto struct {
+	// PeerAddress is the public IP address of the on-premises gateway.
+	PeerAddress string `json:"peerAddress,omitempty"`
+	// Ike contains the parameters of the key exchange (phase 1).
+	Ike *IkeDto `json:"ike,omitempty"`
+	// Ipsec contains the parameters of the IPsec security associations (phase 2).
+	Ipsec *IpsecDto `json:"ipsec,omitempty"`
+	// PreSharedKeySecretRef is the key of the Kubernetes Secret holding the new pre-shared key of the tunnel.
+	PreSharedKeySecretRef *secrets.Ref `json:"preSharedKeySecretRef,omitempty"`
+
+	preSharedKey string
+}
+
+type IkeDto struct {
+	// Version is the version of the IKE protocol.
+	// Allowed values: IKEv1, IKEv2.
+	Version string `json:"version,omitempty"`
+	// Encryption is the encryption algorithm.
+	// Allowed values: AES128, AES192, AES256.
+	Encryption string `json:"encryption,omitempty"`
+	// Integrity is the integrity algorithm.
+	// Allowed values: SHA1, SHA256, SHA384, SHA512.
+	Integrity string `json:"integrity,omitempty"`
+	// DhGroup is the Diffie-Hellman group of the key exchange.
+	// Allowed values: 14, 15, 16, 19, 20, 21.
+	DhGroup int32 `json:"dhGroup,omitempty"`
+	// LifetimeSeconds is the lifetime of the IKE security association.
+	LifetimeSeconds int32 `json:"lifetimeSeconds,omitempty"`
+}
+
+type IpsecDto struct {
+	// Encryption is the encryption algorithm.
+	// Allowed values: AES128, AES192, AES256.
+	Encryption string `json:"encryption,omitempty"`
+	// Integrity is the integrity algorithm.
+	// Allowed values: SHA1, SHA256, SHA384, SHA512.
+	Integrity string `json:"integrity,omitempty"`
+	// PfsGroup is the Diffie-Hellman group of perfect forward secrecy, disabled if empty.
+	// Allowed values: 14, 15, 16, 19, 20, 21.
+	PfsGroup int32 `json:"pfsGroup,omitempty"`
+	// LifetimeSeconds is the lifetime of the IPsec security associations.
+	LifetimeSeconds int32 `json:"lifetimeSeconds,omitempty"`
+}
+
+type VpnRoutePropertiesDto struct {
+	// CloudSubnet is the network of the VPC reached from the on-premises network, e.g. 10.0.1.0/24.
+	CloudSubnet string `json:"cloudSubnet,omitempty"`
+	// OnPremSubnet is the on-premises network reached through the tunnel, e.g. 192.168.10.0/24.
+	OnPremSubnet string `json:"onPremSubnet,omitempty"`
+}
+
+type ReferenceDto struct {
+	// URI is the URI of the referenced resource,
+	// e.g. /projects/<PROJECT_ID>/providers/Aruba.Network/vpcs/<VPC_ID>.
+	URI string `json:"uri,omitempty"`
+}
+
+type VpnTunnelResponseDto struct {
+	Metadata   *MetadataResponseDto            `json:"metadata,omitempty"`
+	Status     *StatusResponseDto              `json:"status,omitempty"`
+	Properties *VpnTunnelPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type VpnRouteResponseDto struct {
+	Metadata   *MetadataResponseDto           `json:"metadata,omitempty"`
+	Status     *StatusResponseDto             `json:"status,omitempty"`
+	Properties *VpnRoutePropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type MetadataResponseDto struct {
+	ID           string               `json:"id,omitempty"`
+	URI          string               `json:"uri,omitempty"`
+	Name         string               `json:"name,omitempty"`
+	Location     *LocationResponseDto `json:"location,omitempty"`
+	Project      *ProjectResponseDto  `json:"project,omitempty"`
+	Tags         []string             `json:"tags,omitempty"`
+	Category     *CategoryResponseDto `json:"category,omitempty"`
+	CreationDate string               `json:"creationDate,omitempty"`
+	CreatedBy    string               `json:"createdBy,omitempty"`
+	UpdateDate   string               `json:"updateDate,omitempty"`
+	UpdatedBy    string               `json:"updatedBy,omitempty"`
+	Version      string               `json:"version,omitempty"`
+	CreatedUser  string               `json:"createdUser,omitempty"`
+	UpdatedUser  string               `json:"updatedUser,omitempty"`
+}
+
+type LocationResponseDto struct {
+	// Code is the code of the region.
+	Code string `json:"code,omitempty"`
+	// Country is the country of the region.
+	Country string `json:"country,omitempty"`
+	// City is the city of the region.
+	City string `json:"city,omitempty"`
+	// Name is the name of the region.
+	Name string `json:"name,omitempty"`
+	// Value is the value of the region.
+	Value string `json:"value,omitempty"`
+}
+
+type ProjectResponseDto struct {
+	// ID is the unique identifier of the project.
+	ID string `json:"id,omitempty"`
+}
+
+type CategoryResponseDto struct {
+	// Name is the name of the category.
+	Name string `json:"name,omitempty"`
+	// Provider is the provider of the category.
+	Provider string `json:"provider,omitempty"`
+	// Typology is the typology of the category.
+	Typology *TypologyResponseDto `json:"typology,omitempty"`
+}
+
+type TypologyResponseDto struct {
+	// ID is the unique identifier of the typology.
+	ID string `json:"id,omitempty"`
+	// Name is the name of the typology.
+	Name string `json:"name,omitempty"`
+}
+
+type StatusResponseDto struct {
+	// State is the state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the status.
+	CreationDate string `json:"creationDate,omitempty"`
+	// DisableStatusInfo contains the information about the disabled status of the resource.
+	DisableStatusInfo *DisableStatusInfoResponseDto `json:"disableStatusInfo,omitempty"`
+	// FailureReason is the reason of the failure, if any.
+	FailureReason string `json:"failureReason,omitempty"`
+}
+
+type DisableStatusInfoResponseDto struct {
+	// IsDisabled indicates if the resource is disabled.
+	IsDisabled bool `json:"isDisabled,omitempty"`
+	// Reasons is a list of reasons for the disabled status.
+	Reasons []string `json:"reasons,omitempty"`
+	// PreviousStatus is the previous status of the resource.
+	PreviousStatus *PreviousStatusResponseDto `json:"previousStatus,omitempty"`
+}
+
+type PreviousStatusResponseDto struct {
+	// State is the previous state of the resource.
+	State string `json:"state,omitempty"`
+	// CreationDate is the creation date of the previous status.
+	CreationDate string `json:"creationDate,omitempty"`
+}
+
+// VpnTunnelPropertiesResponseDto are the properties of a VPN tunnel returned by Aruba Cloud.
+// The pre-shared key is left out, so that it is never part of the responses.
+type VpnTunnelPropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the tunnel, e.g. its VPC and Elastic IP.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// Vpc is the VPC connected by the tunnel.
+	Vpc *ReferenceDto `json:"vpc,omitempty"`
+	// ElasticIp is the Elastic IP the tunnel is established from.
+	ElasticIp *ReferenceDto `json:"elasticIp,omitempty"`
+	// PeerAddress is the public IP address of the on-premises gateway.
+	PeerAddress string `json:"peerAddress,omitempty"`
+	// Ike contains the parameters of the key exchange.
+	Ike *IkeDto `json:"ike,omitempty"`
+	// Ipsec contains the parameters of the IPsec security associations.
+	Ipsec *IpsecDto `json:"ipsec,omitempty"`
+	// ConnectionState is the state of the IPsec connection, e.g. Up or Down.
+	ConnectionState string `json:"connectionState,omitempty"`
+}
+
+type VpnRoutePropertiesResponseDto struct {
+	// LinkedResources is a list of the resources linked to the route, e.g. its tunnel.
+	LinkedResources []LinkedResourceResponseDto `json:"linkedResources,omitempty"`
+	// CloudSubnet is the network of the VPC reached from the on-premises network.
+	CloudSubnet string `json:"cloudSubnet,omitempty"`
+	// OnPremSubnet is the on-premises network reached through the tunnel.
+	OnPremSubnet string `json:"onPremSubnet,omitempty"`
+}
+
+type LinkedResourceResponseDto struct {
+	// URI is the URI of the linked resource.
+	URI string `json:"uri,omitempty"`
+	// StrictCorrelation indicates if the correlation is strict.
+	StrictCorrelation bool `json:"strictCorrelation,omitempty"`
+}
+
+// --------------------------------------------------------------------------
+// Flattened types
+// --------------------------------------------------------------------------
+
+// FlattenedCreateVpnTunnelRequestDto is the flattened request body for creating a VPN tunnel.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateVpnTunnelRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the VPN tunnel.
+	Properties *VpnTunnelRequestPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateVpnTunnelRequestDto is the flattened request body for updating a VPN tunnel.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateVpnTunnelRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the VPN tunnel.
+	Properties *VpnTunnelUpdateRequestPropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedVpnTunnelResponseDto is the flattened response body for a single VPN tunnel.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedVpnTunnelResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the VPN tunnel.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the VPN tunnel.
+	Properties *VpnTunnelPropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedVpnTunnelListResponseDto struct {
+	// Total is the total number of VPN tunnels.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened VPN tunnels.
+	Values []FlattenedVpnTunnelResponseDto `json:"values,omitempty"`
+}
+
+// FlattenedCreateVpnRouteRequestDto is the flattened request body for creating a VPN route.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedCreateVpnRouteRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for the VPN route.
+	Properties *VpnRoutePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedUpdateVpnRouteRequestDto is the flattened request body for updating a VPN route.
+// The fields from MetadataDto are at the root level, alongside the Properties object.
+type FlattenedUpdateVpnRouteRequestDto struct {
+	// Name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource will be located.
+	Location *LocationDto `json:"location,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Properties contains the properties for updating the VPN route.
+	Properties *VpnRoutePropertiesDto `json:"properties,omitempty"`
+}
+
+// FlattenedVpnRouteResponseDto is the flattened response body for a single VPN route.
+// The fields from MetadataResponseDto are at the root level, alongside Status and Properties objects.
+type FlattenedVpnRouteResponseDto struct {
+	// ID is the unique identifier of the resource.
+	ID string `json:"id,omitempty"`
+	// URI is the URI of the resource.
+	URI string `json:"uri,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name,omitempty"`
+	// Location is the region where the resource is located.
+	Location *LocationResponseDto `json:"location,omitempty"`
+	// Project is the project where the resource belongs.
+	Project *ProjectResponseDto `json:"project,omitempty"`
+	// Tags is a list of tags for the resource.
+	Tags []string `json:"tags,omitempty"`
+	// Category is the category of the resource.
+	Category *CategoryResponseDto `json:"category,omitempty"`
+	// CreationDate is the creation date of the resource.
+	CreationDate string `json:"creationDate,omitempty"`
+	// CreatedBy is the user who created the resource.
+	CreatedBy string `json:"createdBy,omitempty"`
+	// UpdateDate is the last update date of the resource.
+	UpdateDate string `json:"updateDate,omitempty"`
+	// UpdatedBy is the user who last updated the resource.
+	UpdatedBy string `json:"updatedBy,omitempty"`
+	// Version is the version of the resource.
+	Version string `json:"version,omitempty"`
+	// CreatedUser is the user who created the resource.
+	CreatedUser string `json:"createdUser,omitempty"`
+	// UpdatedUser is the user who last updated the resource.
+	UpdatedUser string `json:"updatedUser,omitempty"`
+	// Status contains the status of the VPN route.
+	Status *StatusResponseDto `json:"status,omitempty"`
+	// Properties contains the properties of the VPN route.
+	Properties *VpnRoutePropertiesResponseDto `json:"properties,omitempty"`
+}
+
+type FlattenedVpnRouteListResponseDto struct {
+	// Total is the total number of VPN routes.
+	Total int64 `json:"total,omitempty"`
+	// Self is the URI of the current page.
+	Self string `json:"self,omitempty"`
+	// Prev is the URI of the previous page.
+	Prev string `json:"prev,omitempty"`
+	// Next is the URI of the next page.
+	Next string `json:"next,omitempty"`
+	// First is the URI of the first page.
+	First string `json:"first,omitempty"`
+	// Last is the URI of the last page.
+	Last string `json:"last,omitempty"`
+	// Values is a list of flattened VPN routes.
+	Values []FlattenedVpnRouteResponseDto `json:"values,omitempty"`
+}
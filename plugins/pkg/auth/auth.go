@@ -0,0 +1,194 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultTokenURL is the Aruba Cloud OpenID Connect token endpoint for API keys
+	DefaultTokenURL = "https://login.aruba.it/auth/realms/cmp-new-apikey/protocol/openid-connect/token"
+
+	// DefaultExpiryDelta is how long before the actual expiry a cached token is considered expired
+	DefaultExpiryDelta = 30 * time.Second
+
+	// Names of the files, inside the credentials directory, holding the client credentials.
+	// They match the keys of the Kubernetes Secret mounted as a volume.
+	ClientIDFile     = "client-id"
+	ClientSecretFile = "client-secret"
+
+	// Environment variables used when no credentials directory is configured
+	ClientIDEnv     = "ARUBA_CLIENT_ID"
+	ClientSecretEnv = "ARUBA_CLIENT_SECRET"
+)
+
+// TokenProvider returns a valid access token for the Aruba Cloud API
+type TokenProvider interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// Doer is the subset of *http.Client used to call the token endpoint
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Credentials are the OAuth2 client credentials of an Aruba Cloud API key
+type Credentials struct {
+	ClientID     string
+	ClientSecret string
+}
+
+// LoadCredentials reads the client credentials from the files in dir when dir is not empty,
+// otherwise from the ARUBA_CLIENT_ID and ARUBA_CLIENT_SECRET environment variables
+func LoadCredentials(dir string) (Credentials, error) {
+	var creds Credentials
+	if dir != "" {
+		id, err := os.ReadFile(filepath.Join(dir, ClientIDFile))
+		if err != nil {
+			return creds, fmt.Errorf("failed to read client ID: %w", err)
+		}
+		secret, err := os.ReadFile(filepath.Join(dir, ClientSecretFile))
+		if err != nil {
+			return creds, fmt.Errorf("failed to read client secret: %w", err)
+		}
+		creds.ClientID = strings.TrimSpace(string(id))
+		creds.ClientSecret = strings.TrimSpace(string(secret))
+	} else {
+		creds.ClientID = strings.TrimSpace(os.Getenv(ClientIDEnv))
+		creds.ClientSecret = strings.TrimSpace(os.Getenv(ClientSecretEnv))
+	}
+
+	if creds.ClientID == "" || creds.ClientSecret == "" {
+		return creds, fmt.Errorf("client ID and client secret are both required")
+	}
+	return creds, nil
+}
+
+// Options configures a ClientCredentialsProvider
+type Options struct {
+	TokenURL        string        // token endpoint, DefaultTokenURL if empty
+	CredentialsPath string        // directory with the client-id and client-secret files, environment is used if empty
+	Client          Doer          // HTTP client used to call the token endpoint, http.DefaultClient if nil
+	ExpiryDelta     time.Duration // DefaultExpiryDelta if zero
+}
+
+// ClientCredentialsProvider obtains access tokens with the OAuth2 client credentials grant
+// and caches them until shortly before they expire.
+// Concurrent callers share a single in-flight token request.
+type ClientCredentialsProvider struct {
+	tokenURL        string
+	credentialsPath string
+	client          Doer
+	expiryDelta     time.Duration
+	now             func() time.Time
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+var _ TokenProvider = &ClientCredentialsProvider{}
+
+// NewClientCredentialsProvider returns a provider configured with opts.
+// Credentials are read on every token request, so that a rotated Secret is picked up without restarts.
+func NewClientCredentialsProvider(opts Options) *ClientCredentialsProvider {
+	p := &ClientCredentialsProvider{
+		tokenURL:        opts.TokenURL,
+		credentialsPath: opts.CredentialsPath,
+		client:          opts.Client,
+		expiryDelta:     opts.ExpiryDelta,
+		now:             time.Now,
+	}
+	if p.tokenURL == "" {
+		p.tokenURL = DefaultTokenURL
+	}
+	if p.client == nil {
+		p.client = http.DefaultClient
+	}
+	if p.expiryDelta == 0 {
+		p.expiryDelta = DefaultExpiryDelta
+	}
+	return p
+}
+
+// tokenResponse is the subset of the token endpoint response used by the provider
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// Token returns the cached access token, requesting a new one if it is missing or about to expire
+func (p *ClientCredentialsProvider) Token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token != "" && p.now().Before(p.expiry) {
+		return p.token, nil
+	}
+
+	token, expiresIn, err := p.fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	p.token = token
+	p.expiry = p.now().Add(expiresIn - p.expiryDelta)
+	return p.token, nil
+}
+
+func (p *ClientCredentialsProvider) fetch(ctx context.Context) (string, time.Duration, error) {
+	creds, err := LoadCredentials(p.credentialsPath)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to load client credentials: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", creds.ClientID)
+	form.Set("client_secret", creds.ClientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to execute token request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to read token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		// The body is not included since it may echo the submitted credentials
+		return "", 0, fmt.Errorf("token endpoint returned non-200 status: %d", resp.StatusCode)
+	}
+
+	var tr tokenResponse
+	if err := json.Unmarshal(body, &tr); err != nil {
+		return "", 0, fmt.Errorf("failed to unmarshal token response: %w", err)
+	}
+	if tr.AccessToken == "" {
+		return "", 0, fmt.Errorf("token endpoint returned an empty access token")
+	}
+	if tr.TokenType != "" && !strings.EqualFold(tr.TokenType, "bearer") {
+		return "", 0, fmt.Errorf("unsupported token type %q", tr.TokenType)
+	}
+
+	return tr.AccessToken, time.Duration(tr.ExpiresIn) * time.Second, nil
+}
@@ -0,0 +1,184 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTokenServer returns a token endpoint that counts the requests it receives
+func newTokenServer(t *testing.T, calls *int32, expiresIn int) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(calls, 1)
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse form: %v", err)
+		}
+		if r.Form.Get("grant_type") != "client_credentials" {
+			t.Errorf("expected client_credentials grant, got '%s'", r.Form.Get("grant_type"))
+		}
+		if r.Form.Get("client_id") != "my-client" || r.Form.Get("client_secret") != "my-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		// Slow down the endpoint so that concurrent callers overlap
+		time.Sleep(20 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":%d}`, n, expiresIn)
+	}))
+}
+
+func writeCredentials(t *testing.T, id, secret string) string {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, ClientIDFile), []byte(id+"\n"), 0o600); err != nil {
+		t.Fatalf("failed to write client ID: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ClientSecretFile), []byte(secret+"\n"), 0o600); err != nil {
+		t.Fatalf("failed to write client secret: %v", err)
+	}
+	return dir
+}
+
+// TestLoadCredentials tests the LoadCredentials function
+func TestLoadCredentials(t *testing.T) {
+	t.Run("from mounted secret files", func(t *testing.T) {
+		creds, err := LoadCredentials(writeCredentials(t, "my-client", "my-secret"))
+		if err != nil {
+			t.Fatalf("did not expect an error but got: %v", err)
+		}
+		if creds.ClientID != "my-client" || creds.ClientSecret != "my-secret" {
+			t.Errorf("unexpected credentials: %+v", creds)
+		}
+	})
+
+	t.Run("from environment", func(t *testing.T) {
+		t.Setenv(ClientIDEnv, "env-client")
+		t.Setenv(ClientSecretEnv, "env-secret")
+		creds, err := LoadCredentials("")
+		if err != nil {
+			t.Fatalf("did not expect an error but got: %v", err)
+		}
+		if creds.ClientID != "env-client" || creds.ClientSecret != "env-secret" {
+			t.Errorf("unexpected credentials: %+v", creds)
+		}
+	})
+
+	t.Run("missing secret file", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, ClientIDFile), []byte("my-client"), 0o600); err != nil {
+			t.Fatalf("failed to write client ID: %v", err)
+		}
+		if _, err := LoadCredentials(dir); err == nil || !strings.Contains(err.Error(), "failed to read client secret") {
+			t.Errorf("expected missing client secret error, got: %v", err)
+		}
+	})
+
+	t.Run("empty environment", func(t *testing.T) {
+		t.Setenv(ClientIDEnv, "")
+		t.Setenv(ClientSecretEnv, "")
+		if _, err := LoadCredentials(""); err == nil {
+			t.Errorf("expected an error but got none")
+		}
+	})
+}
+
+// TestClientCredentialsProvider_Token tests caching and refresh of tokens
+func TestClientCredentialsProvider_Token(t *testing.T) {
+	var calls int32
+	srv := newTokenServer(t, &calls, 300)
+	defer srv.Close()
+
+	p := NewClientCredentialsProvider(Options{
+		TokenURL:        srv.URL,
+		CredentialsPath: writeCredentials(t, "my-client", "my-secret"),
+		Client:          srv.Client(),
+	})
+	now := time.Now()
+	p.now = func() time.Time { return now }
+
+	token, err := p.Token(context.Background())
+	if err != nil {
+		t.Fatalf("did not expect an error but got: %v", err)
+	}
+	if token != "token-1" {
+		t.Errorf("expected 'token-1', got '%s'", token)
+	}
+
+	// Still valid: served from cache
+	now = now.Add(200 * time.Second)
+	if token, _ = p.Token(context.Background()); token != "token-1" {
+		t.Errorf("expected cached 'token-1', got '%s'", token)
+	}
+
+	// Within the expiry delta: refreshed
+	now = now.Add(80 * time.Second)
+	if token, _ = p.Token(context.Background()); token != "token-2" {
+		t.Errorf("expected refreshed 'token-2', got '%s'", token)
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("expected 2 token requests, got %d", got)
+	}
+}
+
+// TestClientCredentialsProvider_Concurrent tests that concurrent callers share a single token request
+func TestClientCredentialsProvider_Concurrent(t *testing.T) {
+	var calls int32
+	srv := newTokenServer(t, &calls, 300)
+	defer srv.Close()
+
+	p := NewClientCredentialsProvider(Options{
+		TokenURL:        srv.URL,
+		CredentialsPath: writeCredentials(t, "my-client", "my-secret"),
+		Client:          srv.Client(),
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if token, err := p.Token(context.Background()); err != nil || token != "token-1" {
+				t.Errorf("unexpected token '%s' (err: %v)", token, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected a single token request, got %d", got)
+	}
+}
+
+// TestClientCredentialsProvider_Errors tests the failures of the token endpoint
+func TestClientCredentialsProvider_Errors(t *testing.T) {
+	var calls int32
+	srv := newTokenServer(t, &calls, 300)
+	defer srv.Close()
+
+	p := NewClientCredentialsProvider(Options{
+		TokenURL:        srv.URL,
+		CredentialsPath: writeCredentials(t, "my-client", "wrong-secret"),
+		Client:          srv.Client(),
+	})
+
+	_, err := p.Token(context.Background())
+	if err == nil {
+		t.Fatalf("expected an error but got none")
+	}
+	if !strings.Contains(err.Error(), "non-200 status: 401") {
+		t.Errorf("expected non-200 status error, got: %v", err)
+	}
+	if strings.Contains(err.Error(), "wrong-secret") {
+		t.Errorf("error must not contain the client secret: %v", err)
+	}
+}
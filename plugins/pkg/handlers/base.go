@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/logging"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/metrics"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/tracing"
+)
+
+// Base carries the options and the steps shared by the handlers of every plugin:
+// authentication, calls to Aruba Cloud and responses.
+// Handlers of operations not covered by the generic resource handlers embed it.
+type Base struct {
+	HandlerOptions
+}
+
+// NewBase returns a Base with the given options
+func NewBase(opts HandlerOptions) *Base {
+	return &Base{HandlerOptions: opts}
+}
+
+// WithRequestLogger returns r with a logger carrying the values of the given path parameters,
+// on top of the request ID added by the server
+func (b *Base) WithRequestLogger(r *http.Request, pathParams ...string) *http.Request {
+	fields := logging.Fields{}
+	for _, name := range pathParams {
+		if value := r.PathValue(name); value != "" {
+			fields[name] = value
+		}
+	}
+	log := logging.FromContext(r.Context(), b.Log).With(fields)
+	return r.WithContext(logging.WithLogger(r.Context(), log))
+}
+
+// Logger returns the logger of the request
+func (b *Base) Logger(r *http.Request) Logger {
+	return logging.FromContext(r.Context(), b.Log)
+}
+
+// Authorization returns the Authorization header to forward to Aruba Cloud.
+// The header of the incoming request takes precedence, otherwise a token is obtained from the configured provider.
+func (b *Base) Authorization(r *http.Request) (string, error) {
+	if authHeader := r.Header.Get("Authorization"); authHeader != "" {
+		return authHeader, nil
+	}
+	if b.Auth == nil {
+		return "", fmt.Errorf("Authorization header is required")
+	}
+
+	b.Logger(r).Debug("No Authorization header provided, using a token obtained with client credentials")
+	token, err := b.Auth.Token(r.Context())
+	if err != nil {
+		return "", fmt.Errorf("failed to obtain access token: %w", err)
+	}
+	return "Bearer " + token, nil
+}
+
+// MakeArubaCloudRequest calls the Aruba Cloud API. The operation (e.g. "get_subnet") labels metrics, spans and logs.
+// The call is cancelled with ctx, either when the client disconnects or when the upstream deadline is exceeded.
+func (b *Base) MakeArubaCloudRequest(ctx context.Context, operation, method, url string, authHeader string, body []byte) (*http.Response, error) {
+	var bodyReader io.Reader
+	if len(body) > 0 {
+		bodyReader = bytes.NewReader(body)
+	}
+
+	ctx = metrics.WithOperation(ctx, operation)
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	log := logging.FromContext(ctx, b.Log)
+	if authHeader != "" {
+		log.Debug("Using provided Authorization header for Bearer authentication")
+		req.Header.Set("Authorization", authHeader)
+	} else {
+		log.Warn("No Authorization header provided, Bearer authentication required")
+		return nil, fmt.Errorf("no Authorization header provided, Bearer authentication required")
+	}
+
+	if bodyReader != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if id := logging.RequestID(ctx); id != "" {
+		req.Header.Set(logging.RequestIDHeader, id)
+	}
+
+	// Propagate the trace context to Aruba Cloud
+	req, endSpan := tracing.StartClient(req, operation)
+	start := time.Now()
+	resp, err := b.Client.Do(req)
+	endSpan(resp, err)
+
+	fields := logging.Fields{
+		logging.FieldOperation: operation,
+		logging.FieldMethod:    method,
+		logging.FieldDuration:  time.Since(start).Milliseconds(),
+	}
+	if err != nil {
+		log.Error("Aruba Cloud API call failed", err, fields)
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	fields[logging.FieldUpstreamStatus] = resp.StatusCode
+	log.Debug("Aruba Cloud API call completed", fields)
+
+	return resp, nil
+}
+
+// WriteNoContentResponse writes an empty 204 response
+func (b *Base) WriteNoContentResponse(w http.ResponseWriter) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// WriteJSONResponse writes body as a JSON response
+func (b *Base) WriteJSONResponse(w http.ResponseWriter, statusCode int, body []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	w.Write(body)
+}
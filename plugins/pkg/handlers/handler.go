@@ -2,6 +2,10 @@ package handlers
 
 import (
 	"net/http"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/auth"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/logging"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/secrets"
 )
 
 // HTTPClient interface allows mocking of HTTP client
@@ -9,16 +13,17 @@ type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
-// Logger interface allows mocking of logger
-type Logger interface {
-	Print(v ...interface{})
-	Printf(format string, v ...interface{})
-	Println(v ...interface{})
-}
+// Logger interface allows mocking of logger.
+// Use logging.FromContext to get the logger scoped to the request, carrying its request ID.
+type Logger = logging.Logger
 
 type HandlerOptions struct {
-	Client HTTPClient // HTTPClient interface
-	Log    Logger     // Logger interface
+	Client   HTTPClient         // HTTPClient interface
+	Log      Logger             // Logger interface
+	BaseURL  string             // Aruba Cloud API base URL, without trailing slash
+	Auth     auth.TokenProvider // Optional, used when the incoming request has no Authorization header
+	Timeouts UpstreamTimeouts   // Deadlines of the Aruba Cloud calls, by kind of operation
+	Secrets  secrets.Resolver   // Optional, reads the Kubernetes Secrets referenced by the request bodies
 }
 
 // Handler interface
@@ -0,0 +1,60 @@
+// Package handlertest provides the fake Aruba Cloud API the tests of the plugin handlers are served against
+package handlertest
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/logging"
+	"github.com/rs/zerolog"
+)
+
+// Call records a request received by the fake Aruba Cloud API
+type Call struct {
+	Method string
+	URI    string
+	Body   string
+}
+
+// NewOptions returns the options of the handlers under test, backed by a fake Aruba Cloud API answering with respond,
+// and the calls the API receives. The API is closed when the test ends.
+func NewOptions(t testing.TB, respond func(w http.ResponseWriter, r *http.Request)) (handlers.HandlerOptions, *[]Call) {
+	t.Helper()
+	var (
+		mu    sync.Mutex
+		calls []Call
+	)
+	aruba := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		mu.Lock()
+		calls = append(calls, Call{Method: r.Method, URI: r.URL.RequestURI(), Body: string(body)})
+		mu.Unlock()
+		respond(w, r)
+	}))
+	t.Cleanup(aruba.Close)
+
+	opts := handlers.HandlerOptions{Client: aruba.Client(), Log: logging.Discard(), BaseURL: aruba.URL}
+	return opts, &calls
+}
+
+// CaptureLogs sets the logger of opts to a debug logger writing to the returned buffer, to check what the handlers log
+func CaptureLogs(opts *handlers.HandlerOptions) *bytes.Buffer {
+	logs := &bytes.Buffer{}
+	opts.Log = logging.New(zerolog.New(logs).Level(zerolog.DebugLevel))
+	return logs
+}
+
+// Serve serves a request with a bearer token and the given body with handler, returning the recorded response
+func Serve(handler http.Handler, method, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer token")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/logging"
+)
+
+// ProblemContentType is the media type of RFC 7807 error responses
+const ProblemContentType = "application/problem+json"
+
+// ProblemTypeBlank is the RFC 7807 problem type used when no more specific type is available
+const ProblemTypeBlank = "about:blank"
+
+// ProblemDetails is the RFC 7807 error body returned by the plugins.
+// Upstream holds the original JSON Aruba Cloud error body, if any, with its sensitive values redacted.
+type ProblemDetails struct {
+	// Type is a URI identifying the error type.
+	Type string `json:"type,omitempty"`
+	// Title is a short summary of the error type.
+	Title string `json:"title,omitempty"`
+	// Status is the HTTP status code of the response.
+	Status int32 `json:"status,omitempty"`
+	// Detail is a human-readable explanation of the error.
+	Detail string `json:"detail,omitempty"`
+	// Instance is the path of the request that caused the error.
+	Instance string `json:"instance,omitempty"`
+	// Upstream is the original error body returned by Aruba Cloud, if any.
+	Upstream interface{} `json:"upstream,omitempty" swaggertype:"object"`
+} // @name ProblemDetails
+
+// WriteErrorResponse writes a plugin-originated error as an RFC 7807 problem
+func (b *Base) WriteErrorResponse(w http.ResponseWriter, r *http.Request, statusCode int, message string) {
+	if statusCode >= http.StatusInternalServerError {
+		b.Logger(r).Error(message, nil, logging.Fields{logging.FieldStatus: statusCode})
+	} else {
+		b.Logger(r).Warn(message, logging.Fields{logging.FieldStatus: statusCode})
+	}
+	b.WriteProblemResponse(w, ProblemDetails{
+		Type:     ProblemTypeBlank,
+		Title:    http.StatusText(statusCode),
+		Status:   int32(statusCode),
+		Detail:   message,
+		Instance: r.URL.Path,
+	})
+}
+
+// WriteUpstreamFailure writes the response for a call to Aruba Cloud that did not complete:
+// 504 when its deadline is exceeded, nothing when the client has gone away, 500 otherwise
+func (b *Base) WriteUpstreamFailure(w http.ResponseWriter, r *http.Request, err error, message string) {
+	switch {
+	case IsCanceled(err) && r.Context().Err() != nil:
+		b.Logger(r).Info(message + ": request cancelled by the client")
+	case IsTimeout(err):
+		b.WriteErrorResponse(w, r, http.StatusGatewayTimeout, fmt.Sprintf("%s: Aruba Cloud API did not respond in time: %v", message, err))
+	default:
+		b.WriteErrorResponse(w, r, http.StatusInternalServerError, fmt.Sprintf("%s: %v", message, err))
+	}
+}
+
+// WriteUpstreamErrorResponse normalizes an Aruba Cloud error response into an RFC 7807 problem.
+// Type, title and detail of an upstream problem are kept, and the original body is preserved in the upstream extension field.
+// Aruba Cloud errors may echo the request, e.g. a password, so the detail and the body are redacted as in the logs,
+// and a body that is not JSON, which cannot be inspected, is replaced with a generic detail.
+func (b *Base) WriteUpstreamErrorResponse(w http.ResponseWriter, r *http.Request, statusCode int, body []byte) {
+	problem := ProblemDetails{
+		Type:     ProblemTypeBlank,
+		Title:    http.StatusText(statusCode),
+		Status:   int32(statusCode),
+		Instance: r.URL.Path,
+	}
+
+	var upstream ProblemDetails
+	if err := json.Unmarshal(body, &upstream); err == nil {
+		if upstream.Type != "" {
+			problem.Type = upstream.Type
+		}
+		if upstream.Title != "" {
+			problem.Title = upstream.Title
+		}
+		problem.Detail = logging.RedactText(upstream.Detail)
+		if redacted, err := logging.RedactJSON(body); err == nil {
+			problem.Upstream = json.RawMessage(redacted)
+		}
+	} else if len(bytes.TrimSpace(body)) > 0 {
+		problem.Detail = fmt.Sprintf("Aruba Cloud API returned status %d with a non-JSON body of %d bytes", statusCode, len(body))
+	}
+	if problem.Detail == "" {
+		problem.Detail = fmt.Sprintf("Aruba Cloud API returned status %d", statusCode)
+	}
+
+	b.WriteProblemResponse(w, problem)
+}
+
+// WriteProblemResponse writes problem with the application/problem+json content type
+func (b *Base) WriteProblemResponse(w http.ResponseWriter, problem ProblemDetails) {
+	body, err := json.Marshal(problem)
+	if err != nil {
+		b.Log.Error("Failed to marshal problem details", err)
+		w.WriteHeader(int(problem.Status))
+		return
+	}
+
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(int(problem.Status))
+	w.Write(body)
+}
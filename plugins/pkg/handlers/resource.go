@@ -0,0 +1,747 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/logging"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/secrets"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/tracing"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/utils"
+)
+
+// StateDeleted is the state reported by Aruba Cloud for resources that have been deleted
+const StateDeleted = "Deleted"
+
+// Param is a required path or query parameter. Label names it in validation errors.
+type Param struct {
+	Name  string
+	Label string
+}
+
+// APIVersionParam is the api-version query parameter required by every Aruba Cloud operation
+var APIVersionParam = Param{Name: "api-version", Label: "API version"}
+
+// ProjectIDParam is the projectId path parameter scoping every Aruba Cloud resource
+var ProjectIDParam = Param{Name: "projectId", Label: "Project ID"}
+
+// Resource describes an Aruba Cloud resource served by the generic handlers.
+// For example, subnets are declared as:
+//
+//	Resource{
+//		Name:          "subnet",
+//		Plural:        "subnets",
+//		Path:          "/projects/{projectId}/providers/Aruba.Network/vpcs/{vpcId}/subnets",
+//		PathParams:    []Param{ProjectIDParam, {Name: "vpcId", Label: "VPC ID"}},
+//		IDParam:       Param{Name: "id", Label: "Subnet ID"},
+//		QueryParams:   []Param{APIVersionParam},
+//		FlattenPrefix: "metadata",
+//	}
+type Resource struct {
+	Name          string  // Singular name, used in messages and operation names (e.g. "security group")
+	Plural        string  // Plural name, used by the list handler
+	Path          string  // Collection path, relative to the Aruba Cloud base URL, with {param} placeholders
+	PathParams    []Param // Parameters of Path, validated in order
+	IDParam       Param   // Parameter identifying a single resource, appended to Path
+	QueryParams   []Param // Required query parameters, validated after the path parameters
+	FlattenPrefix string  // Object moved to the top level of the responses, usually "metadata"
+}
+
+// Validator is implemented by the flattened request bodies that are checked before calling Aruba Cloud.
+// The error returned by Validate is the detail of the 400 response.
+type Validator interface {
+	Validate() error
+}
+
+// SecretConsumer is implemented by the flattened request bodies referencing Kubernetes Secrets,
+// e.g. the password of a database user. ResolveSecrets is called after Validate to read the referenced values,
+// which are kept out of the custom resources and must never be logged.
+type SecretConsumer interface {
+	ResolveSecrets(ctx context.Context, resolver secrets.Resolver) error
+}
+
+// Enricher is implemented by the Aruba Cloud response DTOs completed with fields computed by the plugin,
+// e.g. the fingerprint of a key pair. Enrich is called before the response is flattened.
+type Enricher interface {
+	Enrich()
+}
+
+// enrich calls Enrich on resp when it implements Enricher
+func enrich[Resp any](resp *Resp) {
+	if e, ok := any(resp).(Enricher); ok {
+		e.Enrich()
+	}
+}
+
+// operation returns the label of an Aruba Cloud operation, e.g. "get_subnet" or "get_kubeconfig_of_kaas_cluster"
+func (res Resource) operation(verb, name string) string {
+	return strings.ReplaceAll(strings.ToLower(verb+" "+name), " ", "_")
+}
+
+// Operation returns the label of the Aruba Cloud operation verb on the resource, e.g. "get_subnet"
+func (res Resource) Operation(verb string) string {
+	return res.operation(verb, res.Name)
+}
+
+// ItemURL returns the Aruba Cloud URL of the single resource identified by the path parameters of r, followed by subpath
+// when not empty (e.g. "disable"), with the required query parameters of r.
+// It serves the plugin handlers calling Aruba Cloud on their own, e.g. to read the state of a resource before an action.
+func (res Resource) ItemURL(baseURL string, r *http.Request, subpath string) string {
+	url := baseURL + res.path(r, true)
+	if subpath != "" {
+		url += "/" + subpath
+	}
+	return url + "?" + res.requiredQuery(r)
+}
+
+// path returns the path of the collection, or of the single resource when withID is set, filled in with the path parameters of r
+func (res Resource) path(r *http.Request, withID bool) string {
+	path := res.Path
+	if withID {
+		path += "/{" + res.IDParam.Name + "}"
+	}
+	for _, p := range res.PathParams {
+		path = strings.ReplaceAll(path, "{"+p.Name+"}", r.PathValue(p.Name))
+	}
+	if withID {
+		path = strings.ReplaceAll(path, "{"+res.IDParam.Name+"}", r.PathValue(res.IDParam.Name))
+	}
+	return path
+}
+
+// requiredQuery returns the encoded required query parameters of r
+func (res Resource) requiredQuery(r *http.Request) string {
+	query := url.Values{}
+	for _, p := range res.QueryParams {
+		query.Set(p.Name, r.URL.Query().Get(p.Name))
+	}
+	return query.Encode()
+}
+
+// ListResponse is the paginated list returned by Aruba Cloud
+type ListResponse[T any] struct {
+	Total  int64  `json:"total,omitempty"`
+	Self   string `json:"self,omitempty"`
+	Prev   string `json:"prev,omitempty"`
+	Next   string `json:"next,omitempty"`
+	First  string `json:"first,omitempty"`
+	Last   string `json:"last,omitempty"`
+	Values []T    `json:"values,omitempty"`
+}
+
+// Get returns the handler retrieving a resource. Resp is the Aruba Cloud response DTO,
+// the response is flattened on Resource.FlattenPrefix.
+func Get[Resp any](opts HandlerOptions, res Resource) Handler {
+	return &getHandler[Resp]{resourceHandler: newResourceHandler(opts, res)}
+}
+
+// List returns the handler listing resources. Resp is the Aruba Cloud DTO of a single resource,
+// Flat its flattened form returned in the values of the list.
+func List[Resp, Flat any](opts HandlerOptions, res Resource) Handler {
+	return &listHandler[Resp, Flat]{resourceHandler: newResourceHandler(opts, res)}
+}
+
+// Create returns the handler creating a resource. Req is the flattened request body,
+// turned by unflatten into the Aruba Cloud request DTO, and Resp is the Aruba Cloud response DTO.
+func Create[Req, Aruba, Resp any](opts HandlerOptions, res Resource, unflatten func(Req) Aruba) Handler {
+	return &writeHandler[Req, Aruba, Resp]{
+		resourceHandler: newResourceHandler(opts, res),
+		unflatten:       unflatten,
+		method:          http.MethodPost,
+		kind:            OperationCreate,
+		verb:            "create",
+		done:            "created",
+		expected:        http.StatusCreated,
+	}
+}
+
+// Update returns the handler updating a resource, see Create
+func Update[Req, Aruba, Resp any](opts HandlerOptions, res Resource, unflatten func(Req) Aruba) Handler {
+	return &writeHandler[Req, Aruba, Resp]{
+		resourceHandler: newResourceHandler(opts, res),
+		unflatten:       unflatten,
+		method:          http.MethodPut,
+		kind:            OperationUpdate,
+		verb:            "update",
+		done:            "updated",
+		withID:          true,
+		expected:        http.StatusOK,
+	}
+}
+
+// Delete returns the handler deleting a resource.
+// Deleting a resource that does not exist or is already in 'Deleted' state is considered successful.
+func Delete(opts HandlerOptions, res Resource) Handler {
+	return &deleteHandler{resourceHandler: newResourceHandler(opts, res)}
+}
+
+// Action returns the handler of an action on a single resource, e.g. powering on a cloud server.
+// The action is a POST without body on {Path}/{id}/{path}, verb names it in messages (e.g. "power on").
+// Resp is the Aruba Cloud response DTO: a response body is flattened as for Get, while an empty one
+// is answered with the status code returned by Aruba Cloud.
+func Action[Resp any](opts HandlerOptions, res Resource, verb, path string) Handler {
+	return &actionHandler[Resp]{resourceHandler: newResourceHandler(opts, res), verb: verb, actionPath: path}
+}
+
+// ActionWithBody returns the handler of an action whose POST carries the JSON body built by body from the request,
+// e.g. from its query parameters. A nil body is not sent, and an error of body is answered with 400 Bad Request
+// before calling Aruba Cloud.
+func ActionWithBody[Resp any](opts HandlerOptions, res Resource, verb, path string, body func(r *http.Request) (any, error)) Handler {
+	return &actionHandler[Resp]{resourceHandler: newResourceHandler(opts, res), verb: verb, actionPath: path, body: body}
+}
+
+// Subresource returns the handler retrieving a document attached to a single resource, e.g. the kubeconfig of a cluster.
+// The document is read with a GET on {Path}/{id}/{path}, name names it in messages (e.g. "kubeconfig").
+// Resp is the Aruba Cloud response DTO: the response is validated against it but not flattened,
+// and its body is never logged since such documents usually carry credentials.
+func Subresource[Resp any](opts HandlerOptions, res Resource, name, path string) Handler {
+	return &subresourceHandler[Resp]{resourceHandler: newResourceHandler(opts, res), name: name, subresourcePath: path}
+}
+
+// Interface compliance verification
+var _ Handler = &getHandler[any]{}
+var _ Handler = &listHandler[any, any]{}
+var _ Handler = &writeHandler[any, any, any]{}
+var _ Handler = &deleteHandler{}
+var _ Handler = &actionHandler[any]{}
+var _ Handler = &subresourceHandler[any]{}
+
+// resourceHandler holds the steps shared by the handlers of a resource
+type resourceHandler struct {
+	*Base
+	res Resource
+}
+
+func newResourceHandler(opts HandlerOptions, res Resource) resourceHandler {
+	return resourceHandler{Base: NewBase(opts), res: res}
+}
+
+type getHandler[Resp any] struct {
+	resourceHandler
+}
+
+type listHandler[Resp, Flat any] struct {
+	resourceHandler
+}
+
+type writeHandler[Req, Aruba, Resp any] struct {
+	resourceHandler
+	unflatten func(Req) Aruba
+	method    string
+	kind      OperationKind
+	verb      string // Verb of the operation, e.g. "create"
+	done      string // Past participle of verb, for the success log
+	withID    bool   // Whether the operation targets a single resource
+	expected  int    // Status code returned by Aruba Cloud on success
+}
+
+type deleteHandler struct {
+	resourceHandler
+}
+
+type actionHandler[Resp any] struct {
+	resourceHandler
+	verb       string                             // Verb of the action, e.g. "power on"
+	actionPath string                             // Path of the action, relative to the resource
+	body       func(r *http.Request) (any, error) // Builds the body of the action, none if nil
+}
+
+type subresourceHandler[Resp any] struct {
+	resourceHandler
+	name            string // Name of the document, e.g. "kubeconfig"
+	subresourcePath string // Path of the document, relative to the resource
+}
+
+// withRequestLogger returns r with a logger carrying the path parameters of the resource
+func (h *resourceHandler) withRequestLogger(r *http.Request) *http.Request {
+	names := make([]string, 0, len(h.res.PathParams)+1)
+	for _, p := range h.res.PathParams {
+		names = append(names, p.Name)
+	}
+	return h.WithRequestLogger(r, append(names, h.res.IDParam.Name)...)
+}
+
+// validate checks the required parameters and returns the Authorization header to forward,
+// writing the error response and returning false when the request cannot be served
+func (h *resourceHandler) validate(w http.ResponseWriter, r *http.Request, withID bool) (string, bool) {
+	params := h.res.PathParams
+	if withID {
+		params = append(params[:len(params):len(params)], h.res.IDParam)
+	}
+	for _, p := range params {
+		if r.PathValue(p.Name) == "" {
+			h.WriteErrorResponse(w, r, http.StatusBadRequest, fmt.Sprintf("%s parameter is required", p.Label))
+			return "", false
+		}
+	}
+
+	queryParams := r.URL.Query()
+	for _, p := range h.res.QueryParams {
+		if queryParams.Get(p.Name) == "" {
+			h.WriteErrorResponse(w, r, http.StatusBadRequest, fmt.Sprintf("%s parameter is required", p.Label))
+			return "", false
+		}
+	}
+
+	authHeader, err := h.Authorization(r)
+	if err != nil {
+		h.WriteErrorResponse(w, r, http.StatusUnauthorized, err.Error())
+		return "", false
+	}
+	return authHeader, true
+}
+
+// path returns the Aruba Cloud URL of the collection, or of the single resource when withID is set, without query
+func (h *resourceHandler) path(r *http.Request, withID bool) string {
+	return h.BaseURL + h.res.path(r, withID)
+}
+
+// requiredQuery returns the encoded required query parameters, the only ones forwarded with a request body
+func (h *resourceHandler) requiredQuery(r *http.Request) string {
+	return h.res.requiredQuery(r)
+}
+
+// call makes the Aruba Cloud request and returns the response body when Aruba Cloud answers with the expected status.
+// Otherwise the error response is written and false is returned.
+func (h *resourceHandler) call(ctx context.Context, w http.ResponseWriter, r *http.Request, verb, name, method, url, authHeader string, body []byte, expected int) ([]byte, bool) {
+	action := verb + " " + name
+	resp, err := h.MakeArubaCloudRequest(ctx, h.res.operation(verb, name), method, url, authHeader, body)
+	if err != nil {
+		h.WriteUpstreamFailure(w, r, err, fmt.Sprintf("Failed to make %s request", action))
+		return nil, false
+	}
+	defer resp.Body.Close()
+
+	// Read the response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		h.WriteUpstreamFailure(w, r, err, fmt.Sprintf("Failed to read %s response", action))
+		return nil, false
+	}
+
+	if resp.StatusCode != expected {
+		h.Logger(r).Warn(fmt.Sprintf("Aruba Cloud API returned non-%d status for %s", expected, action), logging.Fields{logging.FieldUpstreamStatus: resp.StatusCode, logging.FieldUpstreamBody: logging.RedactBody(respBody)})
+		// Proxy the original error response
+		h.WriteUpstreamErrorResponse(w, r, resp.StatusCode, respBody)
+		return nil, false
+	}
+	return respBody, true
+}
+
+// resolveSecrets reads the Secrets referenced by the request body, writing the error response and returning false on failure.
+// A missing Secret is an error of the request, while a failure to read it is an error of the plugin.
+func (h *resourceHandler) resolveSecrets(w http.ResponseWriter, r *http.Request, c SecretConsumer) bool {
+	if h.Secrets == nil {
+		h.WriteErrorResponse(w, r, http.StatusInternalServerError, "Kubernetes Secrets cannot be read, the plugin cannot reach the Kubernetes API")
+		return false
+	}
+	if err := c.ResolveSecrets(r.Context(), h.Secrets); err != nil {
+		if errors.Is(err, secrets.ErrNotFound) || errors.Is(err, secrets.ErrNamespaceNotAllowed) {
+			h.WriteErrorResponse(w, r, http.StatusBadRequest, err.Error())
+		} else {
+			h.WriteErrorResponse(w, r, http.StatusInternalServerError, fmt.Sprintf("Failed to read Kubernetes Secret: %v", err))
+		}
+		return false
+	}
+	return true
+}
+
+// flatten validates body against the Aruba Cloud response DTO and flattens it
+func flatten[Resp any](h *resourceHandler, w http.ResponseWriter, r *http.Request, phases *tracing.Phases, body []byte) ([]byte, bool) {
+	// Unmarshal the response into the Go struct to validate it
+	phases.Next("unmarshal")
+	var arubaResponse Resp
+	if err := json.Unmarshal(body, &arubaResponse); err != nil {
+		h.WriteErrorResponse(w, r, http.StatusInternalServerError, fmt.Sprintf("Failed to unmarshal Aruba Cloud response: %v", err))
+		return nil, false
+	}
+	enrich(&arubaResponse)
+
+	// Marshal the validated struct back to JSON to prepare for flattening
+	validatedBody, err := json.Marshal(arubaResponse)
+	if err != nil {
+		h.WriteErrorResponse(w, r, http.StatusInternalServerError, fmt.Sprintf("Failed to marshal validated response: %v", err))
+		return nil, false
+	}
+
+	phases.Next("flatten")
+	flattenedBody, err := utils.FlattenObject(validatedBody, h.res.FlattenPrefix)
+	if err != nil {
+		h.WriteErrorResponse(w, r, http.StatusInternalServerError, fmt.Sprintf("Failed to flatten response: %v", err))
+		return nil, false
+	}
+	return flattenedBody, true
+}
+
+func (h *getHandler[Resp]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r = h.withRequestLogger(r)
+	phases := tracing.StartPhases(r.Context())
+	defer phases.End()
+
+	phases.Next("validate")
+	authHeader, ok := h.validate(w, r, true)
+	if !ok {
+		return
+	}
+	// All query parameters are forwarded, e.g. ignoreDeletedStatus
+	url := fmt.Sprintf("%s?%s", h.path(r, true), r.URL.Query().Encode())
+
+	ctx, cancel := h.Timeouts.WithUpstreamTimeout(phases.Next("upstream"), OperationGet)
+	defer cancel()
+	body, ok := h.call(ctx, w, r, "get", h.res.Name, http.MethodGet, url, authHeader, nil, http.StatusOK)
+	if !ok {
+		return
+	}
+
+	flattenedBody, ok := flatten[Resp](&h.resourceHandler, w, r, phases, body)
+	if !ok {
+		return
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, flattenedBody)
+	h.Logger(r).Info(fmt.Sprintf("Successfully retrieved and flattened %s", h.res.Name))
+}
+
+func (h *listHandler[Resp, Flat]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r = h.withRequestLogger(r)
+	phases := tracing.StartPhases(r.Context())
+	defer phases.End()
+
+	phases.Next("validate")
+	authHeader, ok := h.validate(w, r, false)
+	if !ok {
+		return
+	}
+	// All query parameters are forwarded, e.g. filter, sort and pagination
+	url := fmt.Sprintf("%s?%s", h.path(r, false), r.URL.Query().Encode())
+
+	ctx, cancel := h.Timeouts.WithUpstreamTimeout(phases.Next("upstream"), OperationList)
+	defer cancel()
+	body, ok := h.call(ctx, w, r, "list", h.res.Plural, http.MethodGet, url, authHeader, nil, http.StatusOK)
+	if !ok {
+		return
+	}
+
+	// Unmarshal the response into the Go struct to validate it
+	phases.Next("unmarshal")
+	var arubaResponse ListResponse[Resp]
+	if err := json.Unmarshal(body, &arubaResponse); err != nil {
+		h.WriteErrorResponse(w, r, http.StatusInternalServerError, fmt.Sprintf("Failed to unmarshal Aruba Cloud response: %v", err))
+		return
+	}
+
+	// Flatten each resource in the response
+	phases.Next("flatten")
+	flattenedValues := make([]Flat, len(arubaResponse.Values))
+	for i := range arubaResponse.Values {
+		enrich(&arubaResponse.Values[i])
+		value := arubaResponse.Values[i]
+		valueBody, err := json.Marshal(value)
+		if err != nil {
+			h.WriteErrorResponse(w, r, http.StatusInternalServerError, fmt.Sprintf("Failed to marshal %s for flattening: %v", h.res.Name, err))
+			return
+		}
+
+		flattenedValueBody, err := utils.FlattenObject(valueBody, h.res.FlattenPrefix)
+		if err != nil {
+			h.WriteErrorResponse(w, r, http.StatusInternalServerError, fmt.Sprintf("Failed to flatten %s: %v", h.res.Name, err))
+			return
+		}
+
+		if err := json.Unmarshal(flattenedValueBody, &flattenedValues[i]); err != nil {
+			h.WriteErrorResponse(w, r, http.StatusInternalServerError, fmt.Sprintf("Failed to unmarshal flattened %s: %v", h.res.Name, err))
+			return
+		}
+	}
+
+	// Construct the flattened list response
+	flattenedResponse := ListResponse[Flat]{
+		Total:  arubaResponse.Total,
+		Self:   arubaResponse.Self,
+		Prev:   arubaResponse.Prev,
+		Next:   arubaResponse.Next,
+		First:  arubaResponse.First,
+		Last:   arubaResponse.Last,
+		Values: flattenedValues,
+	}
+
+	finalBody, err := json.Marshal(flattenedResponse)
+	if err != nil {
+		h.WriteErrorResponse(w, r, http.StatusInternalServerError, fmt.Sprintf("Failed to marshal flattened list response: %v", err))
+		return
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, finalBody)
+	h.Logger(r).Info(fmt.Sprintf("Successfully listed %s", h.res.Plural), logging.Fields{"count": len(flattenedValues)})
+}
+
+func (h *writeHandler[Req, Aruba, Resp]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r = h.withRequestLogger(r)
+	phases := tracing.StartPhases(r.Context())
+	defer phases.End()
+
+	phases.Next("validate")
+	authHeader, ok := h.validate(w, r, h.withID)
+	if !ok {
+		return
+	}
+
+	// Read and parse the flattened request body
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		h.WriteErrorResponse(w, r, http.StatusBadRequest, "Failed to read request body")
+		return
+	}
+
+	var flattenedRequest Req
+	if err := json.Unmarshal(body, &flattenedRequest); err != nil {
+		h.WriteErrorResponse(w, r, http.StatusBadRequest, "Invalid JSON in request body")
+		return
+	}
+
+	if v, ok := any(&flattenedRequest).(Validator); ok {
+		if err := v.Validate(); err != nil {
+			h.WriteErrorResponse(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	if c, ok := any(&flattenedRequest).(SecretConsumer); ok {
+		if !h.resolveSecrets(w, r, c) {
+			return
+		}
+	}
+
+	// "Unflatten" the request body: build the nested structure that Aruba Cloud expects
+	arubaRequestBody, err := json.Marshal(h.unflatten(flattenedRequest))
+	if err != nil {
+		h.WriteErrorResponse(w, r, http.StatusInternalServerError, "Failed to marshal Aruba Cloud request body")
+		return
+	}
+
+	url := fmt.Sprintf("%s?%s", h.path(r, h.withID), h.requiredQuery(r))
+
+	ctx, cancel := h.Timeouts.WithUpstreamTimeout(phases.Next("upstream"), h.kind)
+	defer cancel()
+	respBody, ok := h.call(ctx, w, r, h.verb, h.res.Name, h.method, url, authHeader, arubaRequestBody, h.expected)
+	if !ok {
+		return
+	}
+
+	flattenedBody, ok := flatten[Resp](&h.resourceHandler, w, r, phases, respBody)
+	if !ok {
+		return
+	}
+
+	h.WriteJSONResponse(w, h.expected, flattenedBody)
+	h.Logger(r).Info(fmt.Sprintf("Successfully %s %s", h.done, h.res.Name))
+}
+
+func (h *deleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r = h.withRequestLogger(r)
+	phases := tracing.StartPhases(r.Context())
+	defer phases.End()
+
+	phases.Next("validate")
+	authHeader, ok := h.validate(w, r, true)
+	if !ok {
+		return
+	}
+	// All query parameters are forwarded, e.g. the default subnet handover one (newDefaultSubnet)
+	resourceURL := h.path(r, true)
+	url := fmt.Sprintf("%s?%s", resourceURL, r.URL.Query().Encode())
+
+	ctx, cancel := h.Timeouts.WithUpstreamTimeout(phases.Next("upstream"), OperationDelete)
+	defer cancel()
+	action := "delete " + h.res.Name
+	resp, err := h.MakeArubaCloudRequest(ctx, h.res.operation("delete", h.res.Name), http.MethodDelete, url, authHeader, nil)
+	if err != nil {
+		h.WriteUpstreamFailure(w, r, err, fmt.Sprintf("Failed to make %s request", action))
+		return
+	}
+	defer resp.Body.Close()
+
+	// Read the response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		h.WriteUpstreamFailure(w, r, err, fmt.Sprintf("Failed to read %s response", action))
+		return
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		// Nothing left to delete: report success so that the deletion can be reconciled
+		h.WriteNoContentResponse(w)
+		h.Logger(r).Info(fmt.Sprintf("%s not found, considering it already deleted", capitalize(h.res.Name)))
+		return
+	case resp.StatusCode < 200 || resp.StatusCode > 299:
+		// The resource may be already in 'Deleted' state, in which case Aruba Cloud refuses a new deletion
+		deleted, err := h.isDeleted(ctx, fmt.Sprintf("%s?%s", resourceURL, h.requiredQuery(r)), authHeader)
+		if err != nil {
+			h.Logger(r).Error(fmt.Sprintf("Failed to check the state of %s", h.res.Name), err)
+		}
+		if deleted {
+			h.WriteNoContentResponse(w)
+			h.Logger(r).Info(fmt.Sprintf("%s is already in '%s' state, considering it deleted", capitalize(h.res.Name), StateDeleted))
+			return
+		}
+
+		h.Logger(r).Warn(fmt.Sprintf("Aruba Cloud API returned non-2xx status for %s", action), logging.Fields{logging.FieldUpstreamStatus: resp.StatusCode, logging.FieldUpstreamBody: logging.RedactBody(respBody)})
+		h.WriteUpstreamErrorResponse(w, r, resp.StatusCode, respBody)
+		return
+	}
+
+	// Deletion accepted: the response mirrors the status code returned by Aruba Cloud
+	if resp.StatusCode == http.StatusNoContent {
+		h.WriteNoContentResponse(w)
+	} else {
+		w.WriteHeader(resp.StatusCode)
+	}
+	h.Logger(r).Info(fmt.Sprintf("Successfully requested deletion of %s", h.res.Name))
+}
+
+// isDeleted reports whether the resource at the given URL no longer exists or is in 'Deleted' state
+func (h *deleteHandler) isDeleted(ctx context.Context, url string, authHeader string) (bool, error) {
+	action := "get " + h.res.Name
+	resp, err := h.MakeArubaCloudRequest(ctx, h.res.operation("get", h.res.Name), http.MethodGet, url, authHeader, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to make %s request: %w", action, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return true, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("Aruba Cloud API returned non-200 status for %s: %d", action, resp.StatusCode)
+	}
+
+	var arubaResponse struct {
+		Status *struct {
+			State string `json:"state,omitempty"`
+		} `json:"status,omitempty"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&arubaResponse); err != nil {
+		return false, fmt.Errorf("failed to unmarshal Aruba Cloud response: %w", err)
+	}
+
+	return arubaResponse.Status != nil && arubaResponse.Status.State == StateDeleted, nil
+}
+
+func (h *actionHandler[Resp]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r = h.withRequestLogger(r)
+	phases := tracing.StartPhases(r.Context())
+	defer phases.End()
+
+	phases.Next("validate")
+	authHeader, ok := h.validate(w, r, true)
+	if !ok {
+		return
+	}
+	url := fmt.Sprintf("%s/%s?%s", h.path(r, true), h.actionPath, h.requiredQuery(r))
+	var reqBody []byte
+	if h.body != nil {
+		body, err := h.body(r)
+		if err != nil {
+			h.WriteErrorResponse(w, r, http.StatusBadRequest, err.Error())
+			return
+		}
+		if body != nil {
+			if reqBody, err = json.Marshal(body); err != nil {
+				h.WriteErrorResponse(w, r, http.StatusInternalServerError, "Failed to marshal Aruba Cloud request body")
+				return
+			}
+		}
+	}
+
+	// Actions change the state of the resource, they are bounded like updates
+	ctx, cancel := h.Timeouts.WithUpstreamTimeout(phases.Next("upstream"), OperationUpdate)
+	defer cancel()
+	action := h.verb + " " + h.res.Name
+	resp, err := h.MakeArubaCloudRequest(ctx, h.res.operation(h.verb, h.res.Name), http.MethodPost, url, authHeader, reqBody)
+	if err != nil {
+		h.WriteUpstreamFailure(w, r, err, fmt.Sprintf("Failed to make %s request", action))
+		return
+	}
+	defer resp.Body.Close()
+
+	// Read the response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		h.WriteUpstreamFailure(w, r, err, fmt.Sprintf("Failed to read %s response", action))
+		return
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		h.Logger(r).Warn(fmt.Sprintf("Aruba Cloud API returned non-2xx status for %s", action), logging.Fields{logging.FieldUpstreamStatus: resp.StatusCode, logging.FieldUpstreamBody: logging.RedactBody(respBody)})
+		h.WriteUpstreamErrorResponse(w, r, resp.StatusCode, respBody)
+		return
+	}
+
+	if len(bytes.TrimSpace(respBody)) == 0 {
+		if resp.StatusCode == http.StatusNoContent {
+			h.WriteNoContentResponse(w)
+		} else {
+			w.WriteHeader(resp.StatusCode)
+		}
+	} else {
+		flattenedBody, ok := flatten[Resp](&h.resourceHandler, w, r, phases, respBody)
+		if !ok {
+			return
+		}
+		h.WriteJSONResponse(w, resp.StatusCode, flattenedBody)
+	}
+	h.Logger(r).Info(fmt.Sprintf("Successfully requested %s", action))
+}
+
+func (h *subresourceHandler[Resp]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r = h.withRequestLogger(r)
+	phases := tracing.StartPhases(r.Context())
+	defer phases.End()
+
+	phases.Next("validate")
+	authHeader, ok := h.validate(w, r, true)
+	if !ok {
+		return
+	}
+	url := fmt.Sprintf("%s/%s?%s", h.path(r, true), h.subresourcePath, h.requiredQuery(r))
+
+	ctx, cancel := h.Timeouts.WithUpstreamTimeout(phases.Next("upstream"), OperationGet)
+	defer cancel()
+	name := h.name + " of " + h.res.Name
+	body, ok := h.call(ctx, w, r, "get", name, http.MethodGet, url, authHeader, nil, http.StatusOK)
+	if !ok {
+		return
+	}
+
+	// Unmarshal the response into the Go struct to validate it, the document is returned as is
+	phases.Next("unmarshal")
+	var arubaResponse Resp
+	if err := json.Unmarshal(body, &arubaResponse); err != nil {
+		h.WriteErrorResponse(w, r, http.StatusInternalServerError, fmt.Sprintf("Failed to unmarshal Aruba Cloud response: %v", err))
+		return
+	}
+	validatedBody, err := json.Marshal(arubaResponse)
+	if err != nil {
+		h.WriteErrorResponse(w, r, http.StatusInternalServerError, fmt.Sprintf("Failed to marshal validated response: %v", err))
+		return
+	}
+
+	h.WriteJSONResponse(w, http.StatusOK, validatedBody)
+	h.Logger(r).Info(fmt.Sprintf("Successfully retrieved %s", name))
+}
+
+// capitalize returns s with its first letter in upper case, to start a log message with a resource name
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,444 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/logging"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/secrets"
+)
+
+type testMetadata struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type testStatus struct {
+	State string `json:"state,omitempty"`
+}
+
+type testProperties struct {
+	Size  int32  `json:"size,omitempty"`
+	Label string `json:"label,omitempty"`
+}
+
+type testResource struct {
+	Metadata   *testMetadata   `json:"metadata,omitempty"`
+	Status     *testStatus     `json:"status,omitempty"`
+	Properties *testProperties `json:"properties,omitempty"`
+}
+
+// Enrich computes the size label, as a plugin would compute a field missing in the Aruba Cloud response
+func (res *testResource) Enrich() {
+	if res.Properties != nil {
+		res.Properties.Label = fmt.Sprintf("%dGB", res.Properties.Size)
+	}
+}
+
+type testFlattenedResource struct {
+	ID         string          `json:"id,omitempty"`
+	Name       string          `json:"name,omitempty"`
+	Status     *testStatus     `json:"status,omitempty"`
+	Properties *testProperties `json:"properties,omitempty"`
+}
+
+type testFlattenedRequest struct {
+	Name           string          `json:"name,omitempty"`
+	LabelSecretRef *secrets.Ref    `json:"labelSecretRef,omitempty"`
+	Properties     *testProperties `json:"properties,omitempty"`
+}
+
+func (req testFlattenedRequest) Validate() error {
+	if req.Properties != nil && req.Properties.Size < 0 {
+		return errors.New("size must not be negative")
+	}
+	return nil
+}
+
+var testVolume = Resource{
+	Name:          "volume",
+	Plural:        "volumes",
+	Path:          "/projects/{projectId}/providers/Aruba.Storage/volumes",
+	PathParams:    []Param{ProjectIDParam},
+	IDParam:       Param{Name: "id", Label: "Volume ID"},
+	QueryParams:   []Param{APIVersionParam},
+	FlattenPrefix: "metadata",
+}
+
+// ResolveSecrets reads the label from its Secret, as a plugin would read a password
+func (req *testFlattenedRequest) ResolveSecrets(ctx context.Context, resolver secrets.Resolver) error {
+	if req.LabelSecretRef == nil || req.Properties == nil {
+		return nil
+	}
+	label, err := resolver.Resolve(ctx, *req.LabelSecretRef)
+	if err != nil {
+		return err
+	}
+	req.Properties.Label = label
+	return nil
+}
+
+// testResolver serves the Secret values by reference, from the default namespace only
+type testResolver map[string]string
+
+func (r testResolver) Resolve(ctx context.Context, ref secrets.Ref) (string, error) {
+	if ref.Namespace != "default" {
+		return "", fmt.Errorf("secret %s/%s %w", ref.Namespace, ref.Name, secrets.ErrNamespaceNotAllowed)
+	}
+	value, ok := r[ref.String()]
+	if !ok {
+		return "", fmt.Errorf("%s %w", ref, secrets.ErrNotFound)
+	}
+	return value, nil
+}
+
+// upstreamCall records a request received by the fake Aruba Cloud API
+type upstreamCall struct {
+	method string
+	uri    string
+	body   string
+}
+
+// newTestMux serves the volume handlers, backed by an Aruba Cloud API answering with respond
+func newTestMux(t *testing.T, respond func(w http.ResponseWriter, r *http.Request)) (*http.ServeMux, *[]upstreamCall) {
+	t.Helper()
+	var calls []upstreamCall
+	aruba := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		calls = append(calls, upstreamCall{method: r.Method, uri: r.URL.RequestURI(), body: string(body)})
+		respond(w, r)
+	}))
+	t.Cleanup(aruba.Close)
+
+	opts := HandlerOptions{Client: aruba.Client(), Log: logging.Discard(), BaseURL: aruba.URL, Secrets: testResolver{"default/db key label": "fast"}}
+	unflatten := func(req testFlattenedRequest) testResource {
+		return testResource{Metadata: &testMetadata{Name: req.Name}, Properties: req.Properties}
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /projects/{projectId}/volumes", List[testResource, testFlattenedResource](opts, testVolume))
+	mux.Handle("GET /projects/{projectId}/volumes/{id}", Get[testResource](opts, testVolume))
+	mux.Handle("POST /projects/{projectId}/volumes", Create[testFlattenedRequest, testResource, testResource](opts, testVolume, unflatten))
+	mux.Handle("PUT /projects/{projectId}/volumes/{id}", Update[testFlattenedRequest, testResource, testResource](opts, testVolume, unflatten))
+	mux.Handle("DELETE /projects/{projectId}/volumes/{id}", Delete(opts, testVolume))
+	mux.Handle("POST /projects/{projectId}/volumes/{id}/detach", Action[testResource](opts, testVolume, "detach", "detach"))
+	mux.Handle("POST /projects/{projectId}/volumes/{id}/resize", ActionWithBody[testResource](opts, testVolume, "resize", "resize", testResizeBody))
+	mux.Handle("GET /projects/{projectId}/volumes/{id}/usage", Subresource[testProperties](opts, testVolume, "usage", "usage"))
+	return mux, &calls
+}
+
+// testResizeBody is the body of the resize action, with the size of the query if any
+func testResizeBody(r *http.Request) (any, error) {
+	size := r.URL.Query().Get("size")
+	if size == "" {
+		return nil, nil
+	}
+	if size != "20" && size != "40" {
+		return nil, fmt.Errorf("size must be 20 or 40, got '%s'", size)
+	}
+	return map[string]string{"size": size}, nil
+}
+
+func serve(mux *http.ServeMux, method, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set("Authorization", "Bearer token")
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, req)
+	return rec
+}
+
+// TestResourceHandlers tests the calls made to Aruba Cloud and the flattening of the responses
+func TestResourceHandlers(t *testing.T) {
+	volume := `{"metadata":{"id":"v1","name":"data"},"status":{"state":"Active"},"properties":{"size":20}}`
+	// Single resources are flattened as maps, so their keys are sorted, list values follow the flattened DTO
+	flattened := `{"id":"v1","name":"data","properties":{"label":"20GB","size":20},"status":{"state":"Active"}}`
+	flattenedValue := `{"id":"v1","name":"data","status":{"state":"Active"},"properties":{"size":20,"label":"20GB"}}`
+
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		upstreamStatus int
+		upstreamBody   string
+		expectedCall   upstreamCall
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "get",
+			method:         http.MethodGet,
+			target:         "/projects/p1/volumes/v1?api-version=1.0&ignoreDeletedStatus=true",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   volume,
+			expectedCall:   upstreamCall{method: http.MethodGet, uri: "/projects/p1/providers/Aruba.Storage/volumes/v1?api-version=1.0&ignoreDeletedStatus=true"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "list",
+			method:         http.MethodGet,
+			target:         "/projects/p1/volumes?api-version=1.0&limit=10",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"total":1,"self":"s","values":[` + volume + `]}`,
+			expectedCall:   upstreamCall{method: http.MethodGet, uri: "/projects/p1/providers/Aruba.Storage/volumes?api-version=1.0&limit=10"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"total":1,"self":"s","values":[` + flattenedValue + `]}`,
+		},
+		{
+			name:           "create",
+			method:         http.MethodPost,
+			target:         "/projects/p1/volumes?api-version=1.0&dryRun=true",
+			body:           `{"name":"data","properties":{"size":20}}`,
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   volume,
+			expectedCall:   upstreamCall{method: http.MethodPost, uri: "/projects/p1/providers/Aruba.Storage/volumes?api-version=1.0", body: `{"metadata":{"name":"data"},"properties":{"size":20}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "create with secret",
+			method:         http.MethodPost,
+			target:         "/projects/p1/volumes?api-version=1.0",
+			body:           `{"name":"data","labelSecretRef":{"name":"db","namespace":"default","key":"label"},"properties":{"size":20}}`,
+			upstreamStatus: http.StatusCreated,
+			upstreamBody:   volume,
+			expectedCall:   upstreamCall{method: http.MethodPost, uri: "/projects/p1/providers/Aruba.Storage/volumes?api-version=1.0", body: `{"metadata":{"name":"data"},"properties":{"size":20,"label":"fast"}}`},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "update",
+			method:         http.MethodPut,
+			target:         "/projects/p1/volumes/v1?api-version=1.0",
+			body:           `{"name":"data","properties":{"size":20}}`,
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   volume,
+			expectedCall:   upstreamCall{method: http.MethodPut, uri: "/projects/p1/providers/Aruba.Storage/volumes/v1?api-version=1.0", body: `{"metadata":{"name":"data"},"properties":{"size":20}}`},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "delete",
+			method:         http.MethodDelete,
+			target:         "/projects/p1/volumes/v1?api-version=1.0",
+			upstreamStatus: http.StatusAccepted,
+			expectedCall:   upstreamCall{method: http.MethodDelete, uri: "/projects/p1/providers/Aruba.Storage/volumes/v1?api-version=1.0"},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "action",
+			method:         http.MethodPost,
+			target:         "/projects/p1/volumes/v1/detach?api-version=1.0&force=true",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   volume,
+			expectedCall:   upstreamCall{method: http.MethodPost, uri: "/projects/p1/providers/Aruba.Storage/volumes/v1/detach?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   flattened,
+		},
+		{
+			name:           "action without response body",
+			method:         http.MethodPost,
+			target:         "/projects/p1/volumes/v1/detach?api-version=1.0",
+			upstreamStatus: http.StatusAccepted,
+			expectedCall:   upstreamCall{method: http.MethodPost, uri: "/projects/p1/providers/Aruba.Storage/volumes/v1/detach?api-version=1.0"},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "action with body",
+			method:         http.MethodPost,
+			target:         "/projects/p1/volumes/v1/resize?api-version=1.0&size=40",
+			upstreamStatus: http.StatusAccepted,
+			expectedCall:   upstreamCall{method: http.MethodPost, uri: "/projects/p1/providers/Aruba.Storage/volumes/v1/resize?api-version=1.0", body: `{"size":"40"}`},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "action without body",
+			method:         http.MethodPost,
+			target:         "/projects/p1/volumes/v1/resize?api-version=1.0",
+			upstreamStatus: http.StatusAccepted,
+			expectedCall:   upstreamCall{method: http.MethodPost, uri: "/projects/p1/providers/Aruba.Storage/volumes/v1/resize?api-version=1.0"},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:           "subresource",
+			method:         http.MethodGet,
+			target:         "/projects/p1/volumes/v1/usage?api-version=1.0&limit=10",
+			upstreamStatus: http.StatusOK,
+			upstreamBody:   `{"size":20,"unknown":true}`,
+			expectedCall:   upstreamCall{method: http.MethodGet, uri: "/projects/p1/providers/Aruba.Storage/volumes/v1/usage?api-version=1.0"},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"size":20}`,
+		},
+		{
+			name:           "upstream error is normalized",
+			method:         http.MethodGet,
+			target:         "/projects/p1/volumes/v1?api-version=1.0",
+			upstreamStatus: http.StatusNotFound,
+			upstreamBody:   `{"title":"Not Found","detail":"volume v1 not found"}`,
+			expectedCall:   upstreamCall{method: http.MethodGet, uri: "/projects/p1/providers/Aruba.Storage/volumes/v1?api-version=1.0"},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"type":"about:blank","title":"Not Found","status":404,"detail":"volume v1 not found","instance":"/projects/p1/volumes/v1","upstream":{"title":"Not Found","detail":"volume v1 not found"}}`,
+		},
+		{
+			name:           "upstream error echoing a secret is redacted",
+			method:         http.MethodPost,
+			target:         "/projects/p1/volumes?api-version=1.0",
+			body:           `{"name":"data","labelSecretRef":{"name":"db","namespace":"default","key":"label"},"properties":{"size":20}}`,
+			upstreamStatus: http.StatusBadRequest,
+			upstreamBody:   `{"title":"Bad Request","detail":"invalid volume","request":{"name":"data","adminPassword":"fast"}}`,
+			expectedCall:   upstreamCall{method: http.MethodPost, uri: "/projects/p1/providers/Aruba.Storage/volumes?api-version=1.0", body: `{"metadata":{"name":"data"},"properties":{"size":20,"label":"fast"}}`},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"type":"about:blank","title":"Bad Request","status":400,"detail":"invalid volume","instance":"/projects/p1/volumes","upstream":{"detail":"invalid volume","request":{"adminPassword":"[REDACTED]","name":"data"},"title":"Bad Request"}}`,
+		},
+		{
+			name:           "upstream error detail echoing a secret is redacted",
+			method:         http.MethodGet,
+			target:         "/projects/p1/volumes/v1?api-version=1.0",
+			upstreamStatus: http.StatusBadRequest,
+			upstreamBody:   `{"title":"Bad Request","detail":"adminPassword=fast is too weak"}`,
+			expectedCall:   upstreamCall{method: http.MethodGet, uri: "/projects/p1/providers/Aruba.Storage/volumes/v1?api-version=1.0"},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"type":"about:blank","title":"Bad Request","status":400,"detail":"adminPassword=[REDACTED] is too weak","instance":"/projects/p1/volumes/v1","upstream":{"detail":"adminPassword=[REDACTED] is too weak","title":"Bad Request"}}`,
+		},
+		{
+			name:           "non-JSON upstream error",
+			method:         http.MethodGet,
+			target:         "/projects/p1/volumes/v1?api-version=1.0",
+			upstreamStatus: http.StatusBadGateway,
+			upstreamBody:   `psk=fast rejected by peer`,
+			expectedCall:   upstreamCall{method: http.MethodGet, uri: "/projects/p1/providers/Aruba.Storage/volumes/v1?api-version=1.0"},
+			expectedStatus: http.StatusBadGateway,
+			expectedBody:   `{"type":"about:blank","title":"Bad Gateway","status":502,"detail":"Aruba Cloud API returned status 502 with a non-JSON body of 25 bytes","instance":"/projects/p1/volumes/v1"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.upstreamStatus)
+				w.Write([]byte(tc.upstreamBody))
+			})
+
+			rec := serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 1 || (*calls)[0] != tc.expectedCall {
+				t.Errorf("expected the upstream call %+v, got %+v", tc.expectedCall, *calls)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if got := strings.TrimSpace(rec.Body.String()); got != tc.expectedBody {
+				t.Errorf("expected body '%s', got '%s'", tc.expectedBody, got)
+			}
+		})
+	}
+}
+
+// TestResourceHandlers_Validation tests that incomplete requests are rejected before calling Aruba Cloud
+func TestResourceHandlers_Validation(t *testing.T) {
+	testCases := []struct {
+		name           string
+		method         string
+		target         string
+		body           string
+		expectedDetail string
+	}{
+		{name: "missing api-version", method: http.MethodGet, target: "/projects/p1/volumes/v1", expectedDetail: "API version parameter is required"},
+		{name: "missing api-version on list", method: http.MethodGet, target: "/projects/p1/volumes?limit=10", expectedDetail: "API version parameter is required"},
+		{name: "invalid body", method: http.MethodPost, target: "/projects/p1/volumes?api-version=1.0", body: `{"name":`, expectedDetail: "Invalid JSON in request body"},
+		{name: "invalid request", method: http.MethodPut, target: "/projects/p1/volumes/v1?api-version=1.0", body: `{"properties":{"size":-1}}`, expectedDetail: "size must not be negative"},
+		{name: "missing secret", method: http.MethodPost, target: "/projects/p1/volumes?api-version=1.0", body: `{"labelSecretRef":{"name":"db","namespace":"default","key":"other"},"properties":{"size":20}}`, expectedDetail: "default/db key other not found"},
+		{name: "secret of a namespace not allowed", method: http.MethodPost, target: "/projects/p1/volumes?api-version=1.0", body: `{"labelSecretRef":{"name":"db","namespace":"kube-system","key":"label"},"properties":{"size":20}}`, expectedDetail: "secret kube-system/db namespace not allowed"},
+		{name: "invalid action body", method: http.MethodPost, target: "/projects/p1/volumes/v1/resize?api-version=1.0&size=30", expectedDetail: "size must be 20 or 40, got '30'"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {})
+
+			rec := serve(mux, tc.method, tc.target, tc.body)
+
+			if len(*calls) != 0 {
+				t.Errorf("did not expect calls to Aruba Cloud, got %+v", *calls)
+			}
+			if rec.Code != http.StatusBadRequest || rec.Header().Get("Content-Type") != ProblemContentType {
+				t.Fatalf("expected a 400 problem, got %d '%s'", rec.Code, rec.Header().Get("Content-Type"))
+			}
+			var problem ProblemDetails
+			if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil {
+				t.Fatalf("failed to unmarshal problem: %v", err)
+			}
+			if problem.Detail != tc.expectedDetail {
+				t.Errorf("expected detail '%s', got '%s'", tc.expectedDetail, problem.Detail)
+			}
+		})
+	}
+}
+
+// TestDeleteHandler tests that deleting a resource already gone is considered successful
+func TestDeleteHandler(t *testing.T) {
+	testCases := []struct {
+		name           string
+		deleteStatus   int
+		getStatus      int
+		getBody        string
+		expectedStatus int
+	}{
+		{name: "not found", deleteStatus: http.StatusNotFound, expectedStatus: http.StatusNoContent},
+		{name: "already deleted", deleteStatus: http.StatusConflict, getStatus: http.StatusOK, getBody: `{"status":{"state":"Deleted"}}`, expectedStatus: http.StatusNoContent},
+		{name: "not deleted", deleteStatus: http.StatusConflict, getStatus: http.StatusOK, getBody: `{"status":{"state":"InUse"}}`, expectedStatus: http.StatusConflict},
+		{name: "deleted meanwhile", deleteStatus: http.StatusConflict, getStatus: http.StatusNotFound, expectedStatus: http.StatusNoContent},
+		{name: "no content", deleteStatus: http.StatusNoContent, expectedStatus: http.StatusNoContent},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mux, calls := newTestMux(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					w.WriteHeader(tc.deleteStatus)
+					return
+				}
+				w.WriteHeader(tc.getStatus)
+				w.Write([]byte(tc.getBody))
+			})
+
+			rec := serve(mux, http.MethodDelete, "/projects/p1/volumes/v1?api-version=1.0", "")
+
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("expected status %d, got %d", tc.expectedStatus, rec.Code)
+			}
+			if tc.getStatus != 0 && (len(*calls) != 2 || (*calls)[1].uri != "/projects/p1/providers/Aruba.Storage/volumes/v1?api-version=1.0") {
+				t.Errorf("expected the state of the volume to be checked, got %+v", *calls)
+			}
+		})
+	}
+}
+
+// TestResource_ItemURL tests the URLs of the resources called by the plugin handlers on their own
+func TestResource_ItemURL(t *testing.T) {
+	var got, gotAction string
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /projects/{projectId}/volumes/{id}/detach", func(w http.ResponseWriter, r *http.Request) {
+		got = testVolume.ItemURL("https://api.arubacloud.com", r, "")
+		gotAction = testVolume.ItemURL("https://api.arubacloud.com", r, "detach")
+	})
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/projects/p1/volumes/v1/detach?api-version=1.0&force=true", nil))
+
+	if expected := "https://api.arubacloud.com/projects/p1/providers/Aruba.Storage/volumes/v1?api-version=1.0"; got != expected {
+		t.Errorf("expected '%s', got '%s'", expected, got)
+	}
+	if expected := "https://api.arubacloud.com/projects/p1/providers/Aruba.Storage/volumes/v1/detach?api-version=1.0"; gotAction != expected {
+		t.Errorf("expected '%s', got '%s'", expected, gotAction)
+	}
+	if got := testVolume.Operation("get"); got != "get_volume" {
+		t.Errorf("expected operation 'get_volume', got '%s'", got)
+	}
+}
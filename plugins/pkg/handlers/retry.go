@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// IdempotencyKeyHeader marks a POST request as safe to retry
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// Default retry settings, used for zero values of RetryOptions
+const (
+	DefaultRetryMaxAttempts    = 3
+	DefaultRetryInitialBackoff = 200 * time.Millisecond
+	DefaultRetryMaxBackoff     = 5 * time.Second
+)
+
+// RetryOptions configures a RetryClient
+type RetryOptions struct {
+	MaxAttempts    int           // Total number of attempts, including the first one
+	InitialBackoff time.Duration // Upper bound of the wait before the first retry
+	MaxBackoff     time.Duration // Upper bound of any wait, including the ones requested with Retry-After
+}
+
+// RetryClient is an HTTPClient that retries transient Aruba Cloud failures
+// (429, 502, 503, 504 and connection resets) with jittered exponential backoff.
+// Only idempotent requests are retried: GET, HEAD, OPTIONS, PUT, DELETE and
+// POST requests carrying an Idempotency-Key header.
+type RetryClient struct {
+	client HTTPClient
+	opts   RetryOptions
+	sleep  func(ctx context.Context, d time.Duration) error
+	jitter func(n int64) int64
+}
+
+var _ HTTPClient = &RetryClient{}
+
+// NewRetryClient wraps client with the retry policy described by opts
+func NewRetryClient(client HTTPClient, opts RetryOptions) *RetryClient {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = DefaultRetryMaxAttempts
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = DefaultRetryInitialBackoff
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = DefaultRetryMaxBackoff
+	}
+	return &RetryClient{
+		client: client,
+		opts:   opts,
+		sleep:  sleepContext,
+		jitter: rand.Int64N,
+	}
+}
+
+// Do executes the request, retrying it while the failure is transient and attempts are left
+func (c *RetryClient) Do(req *http.Request) (*http.Response, error) {
+	retryable := isIdempotent(req) && (req.Body == nil || req.Body == http.NoBody || req.GetBody != nil)
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.client.Do(req)
+		if !retryable || attempt >= c.opts.MaxAttempts || !shouldRetry(resp, err) {
+			return resp, err
+		}
+
+		wait := c.backoff(attempt, resp)
+		if resp != nil {
+			// Drain the body to let the connection be reused
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		if err := c.sleep(req.Context(), wait); err != nil {
+			return nil, fmt.Errorf("retry aborted after %d attempts: %w", attempt, err)
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+	}
+}
+
+// backoff returns the wait before the next attempt: the Retry-After value when present,
+// otherwise a random duration up to the exponential backoff for the attempt (full jitter)
+func (c *RetryClient) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, c.opts.MaxBackoff)
+		}
+	}
+
+	ceiling := c.opts.InitialBackoff << (attempt - 1)
+	if ceiling <= 0 || ceiling > c.opts.MaxBackoff {
+		ceiling = c.opts.MaxBackoff
+	}
+	return time.Duration(c.jitter(int64(ceiling)) + 1)
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return req.Header.Get(IdempotencyKeyHeader) != ""
+	}
+	return false
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return errors.Is(err, syscall.ECONNRESET) ||
+			errors.Is(err, syscall.ECONNREFUSED) ||
+			errors.Is(err, io.ErrUnexpectedEOF) ||
+			errors.Is(err, io.EOF)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header expressed either in seconds or as an HTTP date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
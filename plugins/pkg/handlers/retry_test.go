@@ -0,0 +1,251 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// fakeClient replays a sequence of responses and errors, recording the request bodies it receives
+type fakeClient struct {
+	results []result
+	bodies  []string
+	calls   int
+}
+
+type result struct {
+	status     int
+	retryAfter string
+	err        error
+}
+
+func (f *fakeClient) Do(req *http.Request) (*http.Response, error) {
+	r := f.results[f.calls]
+	f.calls++
+
+	if req.Body != nil {
+		b, _ := io.ReadAll(req.Body)
+		f.bodies = append(f.bodies, string(b))
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	resp := &http.Response{
+		StatusCode: r.status,
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader("{}")),
+	}
+	if r.retryAfter != "" {
+		resp.Header.Set("Retry-After", r.retryAfter)
+	}
+	return resp, nil
+}
+
+// newTestRetryClient returns a RetryClient that records the waits instead of sleeping
+func newTestRetryClient(client HTTPClient, waits *[]time.Duration) *RetryClient {
+	c := NewRetryClient(client, RetryOptions{MaxAttempts: 3, InitialBackoff: 100 * time.Millisecond, MaxBackoff: 2 * time.Second})
+	c.sleep = func(ctx context.Context, d time.Duration) error {
+		*waits = append(*waits, d)
+		return nil
+	}
+	c.jitter = func(n int64) int64 { return n - 1 } // always the upper bound
+	return c
+}
+
+// TestRetryClient_Do tests which requests are retried and how many times
+func TestRetryClient_Do(t *testing.T) {
+	connReset := fmt.Errorf("read tcp: %w", syscall.ECONNRESET)
+
+	tests := []struct {
+		name           string
+		method         string
+		header         http.Header
+		results        []result
+		expectedCalls  int
+		expectedStatus int
+		expectErr      bool
+	}{
+		{
+			name:           "GET retried on 503 until success",
+			method:         http.MethodGet,
+			results:        []result{{status: 503}, {status: 502}, {status: 200}},
+			expectedCalls:  3,
+			expectedStatus: 200,
+		},
+		{
+			name:           "GET gives up after max attempts",
+			method:         http.MethodGet,
+			results:        []result{{status: 429}, {status: 429}, {status: 429}},
+			expectedCalls:  3,
+			expectedStatus: 429,
+		},
+		{
+			name:           "PUT retried on connection reset",
+			method:         http.MethodPut,
+			results:        []result{{err: connReset}, {status: 200}},
+			expectedCalls:  2,
+			expectedStatus: 200,
+		},
+		{
+			name:           "DELETE retried on 504",
+			method:         http.MethodDelete,
+			results:        []result{{status: 504}, {status: 202}},
+			expectedCalls:  2,
+			expectedStatus: 202,
+		},
+		{
+			name:           "POST never retried without idempotency key",
+			method:         http.MethodPost,
+			results:        []result{{status: 503}},
+			expectedCalls:  1,
+			expectedStatus: 503,
+		},
+		{
+			name:          "POST not retried on connection reset without idempotency key",
+			method:        http.MethodPost,
+			results:       []result{{err: connReset}},
+			expectedCalls: 1,
+			expectErr:     true,
+		},
+		{
+			name:           "POST retried with idempotency key",
+			method:         http.MethodPost,
+			header:         http.Header{IdempotencyKeyHeader: []string{"abc"}},
+			results:        []result{{status: 503}, {status: 201}},
+			expectedCalls:  2,
+			expectedStatus: 201,
+		},
+		{
+			name:           "client errors are not retried",
+			method:         http.MethodGet,
+			results:        []result{{status: 404}},
+			expectedCalls:  1,
+			expectedStatus: 404,
+		},
+		{
+			name:           "server errors other than gateway ones are not retried",
+			method:         http.MethodGet,
+			results:        []result{{status: 500}},
+			expectedCalls:  1,
+			expectedStatus: 500,
+		},
+		{
+			name:          "generic transport errors are not retried",
+			method:        http.MethodGet,
+			results:       []result{{err: fmt.Errorf("tls: handshake failure")}},
+			expectedCalls: 1,
+			expectErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeClient{results: tt.results}
+			var waits []time.Duration
+			c := newTestRetryClient(fake, &waits)
+
+			req, _ := http.NewRequest(tt.method, "http://aruba.test/projects", bytes.NewReader([]byte(`{"name":"x"}`)))
+			for k, v := range tt.header {
+				req.Header[k] = v
+			}
+
+			resp, err := c.Do(req)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("did not expect an error but got: %v", err)
+				}
+				if resp.StatusCode != tt.expectedStatus {
+					t.Errorf("expected status %d, got %d", tt.expectedStatus, resp.StatusCode)
+				}
+			}
+
+			if fake.calls != tt.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tt.expectedCalls, fake.calls)
+			}
+			// The body must be replayed identically on every attempt
+			for i, b := range fake.bodies {
+				if b != `{"name":"x"}` {
+					t.Errorf("attempt %d sent unexpected body '%s'", i+1, b)
+				}
+			}
+		})
+	}
+}
+
+// TestRetryClient_Backoff tests exponential backoff and Retry-After handling
+func TestRetryClient_Backoff(t *testing.T) {
+	t.Run("exponential backoff", func(t *testing.T) {
+		fake := &fakeClient{results: []result{{status: 503}, {status: 503}, {status: 200}}}
+		var waits []time.Duration
+		c := newTestRetryClient(fake, &waits)
+
+		req, _ := http.NewRequest(http.MethodGet, "http://aruba.test", nil)
+		if _, err := c.Do(req); err != nil {
+			t.Fatalf("did not expect an error but got: %v", err)
+		}
+
+		expected := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}
+		if fmt.Sprint(waits) != fmt.Sprint(expected) {
+			t.Errorf("expected waits %v, got %v", expected, waits)
+		}
+	})
+
+	t.Run("Retry-After in seconds is honored and capped", func(t *testing.T) {
+		fake := &fakeClient{results: []result{{status: 429, retryAfter: "1"}, {status: 429, retryAfter: "120"}, {status: 200}}}
+		var waits []time.Duration
+		c := newTestRetryClient(fake, &waits)
+
+		req, _ := http.NewRequest(http.MethodGet, "http://aruba.test", nil)
+		if _, err := c.Do(req); err != nil {
+			t.Fatalf("did not expect an error but got: %v", err)
+		}
+
+		expected := []time.Duration{time.Second, 2 * time.Second}
+		if fmt.Sprint(waits) != fmt.Sprint(expected) {
+			t.Errorf("expected waits %v, got %v", expected, waits)
+		}
+	})
+
+	t.Run("cancelled context stops retries", func(t *testing.T) {
+		fake := &fakeClient{results: []result{{status: 503}, {status: 200}}}
+		c := NewRetryClient(fake, RetryOptions{MaxAttempts: 3, InitialBackoff: time.Hour, MaxBackoff: time.Hour})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://aruba.test", nil)
+		if _, err := c.Do(req); err == nil || !strings.Contains(err.Error(), "retry aborted") {
+			t.Errorf("expected retry aborted error, got: %v", err)
+		}
+		if fake.calls != 1 {
+			t.Errorf("expected 1 call, got %d", fake.calls)
+		}
+	})
+}
+
+// TestParseRetryAfter tests the parsing of the Retry-After header
+func TestParseRetryAfter(t *testing.T) {
+	if d, ok := parseRetryAfter("3"); !ok || d != 3*time.Second {
+		t.Errorf("expected 3s, got %v (ok: %v)", d, ok)
+	}
+	if _, ok := parseRetryAfter(""); ok {
+		t.Errorf("expected empty value to be ignored")
+	}
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Errorf("expected invalid value to be ignored")
+	}
+	date := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	if d, ok := parseRetryAfter(date); !ok || d <= 0 || d > 10*time.Second {
+		t.Errorf("expected a wait up to 10s, got %v (ok: %v)", d, ok)
+	}
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+)
+
+// Default deadlines of the calls to Aruba Cloud, including retries.
+// They stay below the 50s write timeout of the server, so that the 504 response can still be written.
+const (
+	DefaultUpstreamTimeoutGet    = 10 * time.Second
+	DefaultUpstreamTimeoutList   = 15 * time.Second
+	DefaultUpstreamTimeoutCreate = 40 * time.Second
+	DefaultUpstreamTimeoutUpdate = 40 * time.Second
+	DefaultUpstreamTimeoutDelete = 30 * time.Second
+)
+
+// OperationKind is the kind of operation performed on an Aruba Cloud resource
+type OperationKind int
+
+const (
+	OperationGet OperationKind = iota
+	OperationList
+	OperationCreate
+	OperationUpdate
+	OperationDelete
+)
+
+// UpstreamTimeouts are the deadlines of the calls to Aruba Cloud for each kind of operation.
+// A deadline covers every attempt of a call, including the waits between retries. Zero means no deadline.
+type UpstreamTimeouts struct {
+	Get    time.Duration
+	List   time.Duration
+	Create time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// DefaultUpstreamTimeouts returns the default deadlines
+func DefaultUpstreamTimeouts() UpstreamTimeouts {
+	return UpstreamTimeouts{
+		Get:    DefaultUpstreamTimeoutGet,
+		List:   DefaultUpstreamTimeoutList,
+		Create: DefaultUpstreamTimeoutCreate,
+		Update: DefaultUpstreamTimeoutUpdate,
+		Delete: DefaultUpstreamTimeoutDelete,
+	}
+}
+
+// For returns the deadline of the given kind of operation
+func (t UpstreamTimeouts) For(kind OperationKind) time.Duration {
+	switch kind {
+	case OperationGet:
+		return t.Get
+	case OperationList:
+		return t.List
+	case OperationCreate:
+		return t.Create
+	case OperationUpdate:
+		return t.Update
+	case OperationDelete:
+		return t.Delete
+	}
+	return 0
+}
+
+// WithUpstreamTimeout returns a copy of ctx with the deadline of the given kind of operation.
+// The returned cancel function must be called once the Aruba Cloud response has been read.
+func (t UpstreamTimeouts) WithUpstreamTimeout(ctx context.Context, kind OperationKind) (context.Context, context.CancelFunc) {
+	timeout := t.For(kind)
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// IsTimeout reports whether err is caused by an exceeded deadline, either the one of the context
+// or the timeout of a single attempt of the HTTP client
+func IsTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// IsCanceled reports whether err is caused by the cancellation of the incoming request,
+// typically because the client disconnected
+func IsCanceled(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
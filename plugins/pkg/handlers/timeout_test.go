@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestUpstreamTimeouts tests the deadline applied to each kind of operation
+func TestUpstreamTimeouts(t *testing.T) {
+	timeouts := UpstreamTimeouts{Get: time.Second, List: 2 * time.Second, Create: 3 * time.Second}
+
+	testCases := []struct {
+		kind        OperationKind
+		expected    time.Duration
+		hasDeadline bool
+	}{
+		{kind: OperationGet, expected: time.Second, hasDeadline: true},
+		{kind: OperationList, expected: 2 * time.Second, hasDeadline: true},
+		{kind: OperationCreate, expected: 3 * time.Second, hasDeadline: true},
+		{kind: OperationUpdate, expected: 0, hasDeadline: false},
+		{kind: OperationDelete, expected: 0, hasDeadline: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(fmt.Sprintf("kind %d", tc.kind), func(t *testing.T) {
+			if got := timeouts.For(tc.kind); got != tc.expected {
+				t.Errorf("expected timeout %v, got %v", tc.expected, got)
+			}
+
+			ctx, cancel := timeouts.WithUpstreamTimeout(context.Background(), tc.kind)
+			defer cancel()
+			if _, ok := ctx.Deadline(); ok != tc.hasDeadline {
+				t.Errorf("expected deadline set to be %v, got %v", tc.hasDeadline, ok)
+			}
+		})
+	}
+}
+
+// TestIsTimeout tests the classification of the errors of an upstream call
+func TestIsTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	t.Run("context deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		_, err := srv.Client().Do(req)
+		if !IsTimeout(err) || IsCanceled(err) {
+			t.Errorf("expected a timeout, got: %v", err)
+		}
+	})
+
+	t.Run("client timeout", func(t *testing.T) {
+		client := srv.Client()
+		client.Timeout = 10 * time.Millisecond
+		req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+		_, err := client.Do(req)
+		if !IsTimeout(err) {
+			t.Errorf("expected a timeout, got: %v", err)
+		}
+	})
+
+	t.Run("cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+		_, err := srv.Client().Do(req)
+		if IsTimeout(err) || !IsCanceled(err) {
+			t.Errorf("expected a cancellation, got: %v", err)
+		}
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		if err := fmt.Errorf("tls: handshake failure"); IsTimeout(err) || IsCanceled(err) {
+			t.Errorf("did not expect %v to be a timeout or a cancellation", err)
+		}
+	})
+}
@@ -2,13 +2,72 @@ package health
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
+// Default readiness settings, used for zero values of ReadinessOptions
+const (
+	DefaultReadinessCacheTTL = 10 * time.Second
+	DefaultReadinessTimeout  = 5 * time.Second
+)
+
+// Status values reported by the readiness endpoint
+const (
+	StatusReady    = "ready"
+	StatusNotReady = "not ready"
+	StatusUp       = "up"
+	StatusDown     = "down"
+)
+
+// Checker verifies that a dependency of the plugin is reachable
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+type checkerFunc struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+func (c checkerFunc) Name() string                    { return c.name }
+func (c checkerFunc) Check(ctx context.Context) error { return c.check(ctx) }
+
+// CheckerFunc returns a Checker named name that runs check
+func CheckerFunc(name string, check func(ctx context.Context) error) Checker {
+	return checkerFunc{name: name, check: check}
+}
+
+// HTTPChecker returns a Checker that sends a GET request to url.
+// Any response below 500 means the dependency is up: 4xx responses (e.g. 401 for an
+// unauthenticated request) still prove that the endpoint is reachable and serving.
+func HTTPChecker(name, url string, client *http.Client) Checker {
+	return CheckerFunc(name, func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return fmt.Errorf("failed to create request: %w", err)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to reach %s: %w", url, err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode >= http.StatusInternalServerError {
+			return fmt.Errorf("%s returned status %d", url, resp.StatusCode)
+		}
+		return nil
+	})
+}
+
 // LivenessHandler implements Kubernetes liveness probe
 // Returns 200 if the application is running and hasn't deadlocked
 func LivenessHandler(healthy *int32) http.HandlerFunc {
@@ -23,48 +82,113 @@ func LivenessHandler(healthy *int32) http.HandlerFunc {
 	}
 }
 
+// ReadinessOptions configures the readiness probe
+type ReadinessOptions struct {
+	CacheTTL time.Duration // How long the result of the checks is reused before running them again
+	Timeout  time.Duration // Timeout of a single run of all the checks
+}
+
+// CheckResult is the status of a single dependency
+type CheckResult struct {
+	Status    string `json:"status"`
+	Error     string `json:"error,omitempty"`
+	LatencyMs int64  `json:"latencyMs"`
+}
+
+// ReadinessResult is the body returned by the readiness endpoint
+type ReadinessResult struct {
+	Status    string                 `json:"status"`
+	CheckedAt *time.Time             `json:"checkedAt,omitempty"`
+	Checks    map[string]CheckResult `json:"checks,omitempty"`
+}
+
+// readiness runs the checkers and caches their result
+type readiness struct {
+	checkers []Checker
+	opts     ReadinessOptions
+	now      func() time.Time
+
+	mu     sync.Mutex
+	result ReadinessResult
+	expiry time.Time
+}
+
 // ReadinessHandler implements Kubernetes readiness probe
-// Returns 200 if the application is ready to serve traffic
-// For a proxy service like this one, this includes checking connectivity to GitHub API
-func ReadinessHandler(ready *int32, client *http.Client) http.HandlerFunc {
+// Returns 200 if the application is ready to serve traffic and every dependency checked by checkers is up.
+// The result of the checks is cached for opts.CacheTTL, so that frequent probes do not hammer the dependencies,
+// and is reported per dependency in a JSON body.
+func ReadinessHandler(ready *int32, opts ReadinessOptions, checkers ...Checker) http.HandlerFunc {
+	if opts.CacheTTL <= 0 {
+		opts.CacheTTL = DefaultReadinessCacheTTL
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultReadinessTimeout
+	}
+	rd := &readiness{checkers: checkers, opts: opts, now: time.Now}
+
 	return func(w http.ResponseWriter, r *http.Request) {
-		// First check if the service is marked as ready
+		// First check if the service is marked as ready, dependencies are irrelevant while shutting down
 		if atomic.LoadInt32(ready) == 0 {
-			w.WriteHeader(http.StatusServiceUnavailable)
-			w.Write([]byte("Service Not Ready"))
+			writeResult(w, ReadinessResult{Status: StatusNotReady})
 			return
 		}
 
-		// For a GitHub API proxy, we verify we can reach GitHub
-		ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
-		defer cancel()
+		writeResult(w, rd.check(r.Context()))
+	}
+}
 
-		req, err := http.NewRequestWithContext(ctx, "GET", "https://api.github.com", nil)
-		if err != nil {
-			log.Debug().Err(err).Msg("failed to create GitHub API request for readiness check")
-			w.WriteHeader(http.StatusServiceUnavailable)
-			w.Write([]byte("GitHub API Unreachable"))
-			return
-		}
+// check returns the cached result if still valid, otherwise runs all the checkers concurrently.
+// Concurrent probes share a single run of the checks.
+func (rd *readiness) check(ctx context.Context) ReadinessResult {
+	rd.mu.Lock()
+	defer rd.mu.Unlock()
 
-		resp, err := client.Do(req)
-		if err != nil {
-			log.Debug().Err(err).Msg("failed to reach GitHub API for readiness check")
-			w.WriteHeader(http.StatusServiceUnavailable)
-			w.Write([]byte("GitHub API Unreachable"))
-			return
-		}
-		defer resp.Body.Close()
+	if rd.result.Status != "" && rd.now().Before(rd.expiry) {
+		return rd.result
+	}
 
-		// GitHub API should respond with some 2xx or 4xx status (4xx is still fine, means GitHub API is up)
-		if resp.StatusCode >= 200 && resp.StatusCode < 500 {
-			w.WriteHeader(http.StatusOK)
-			w.Write([]byte("Ready"))
-			return
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), rd.opts.Timeout)
+	defer cancel()
+
+	results := make([]CheckResult, len(rd.checkers))
+	var wg sync.WaitGroup
+	for i, c := range rd.checkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := time.Now()
+			err := c.Check(ctx)
+			results[i] = CheckResult{Status: StatusUp, LatencyMs: time.Since(start).Milliseconds()}
+			if err != nil {
+				log.Debug().Err(err).Str("dependency", c.Name()).Msg("readiness check failed")
+				results[i].Status = StatusDown
+				results[i].Error = err.Error()
+			}
+		}()
+	}
+	wg.Wait()
+
+	checkedAt := rd.now().UTC()
+	result := ReadinessResult{Status: StatusReady, CheckedAt: &checkedAt, Checks: make(map[string]CheckResult, len(results))}
+	for i, c := range rd.checkers {
+		result.Checks[c.Name()] = results[i]
+		if results[i].Status != StatusUp {
+			result.Status = StatusNotReady
 		}
+	}
+
+	rd.result = result
+	rd.expiry = rd.now().Add(rd.opts.CacheTTL)
+	return result
+}
 
-		log.Debug().Int("status", resp.StatusCode).Msg("GitHub API returned unexpected status for readiness check")
+func writeResult(w http.ResponseWriter, result ReadinessResult) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	if result.Status == StatusReady {
+		w.WriteHeader(http.StatusOK)
+	} else {
 		w.WriteHeader(http.StatusServiceUnavailable)
-		w.Write([]byte("GitHub API Error"))
 	}
+	json.NewEncoder(w).Encode(result)
 }
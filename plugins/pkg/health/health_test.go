@@ -0,0 +1,171 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// countingChecker counts its runs and fails when err is set
+type countingChecker struct {
+	name  string
+	err   error
+	calls int32
+}
+
+func (c *countingChecker) Name() string { return c.name }
+
+func (c *countingChecker) Check(ctx context.Context) error {
+	atomic.AddInt32(&c.calls, 1)
+	return c.err
+}
+
+func probe(t *testing.T, h http.HandlerFunc) (int, ReadinessResult) {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	var result ReadinessResult
+	if err := json.Unmarshal(rec.Body.Bytes(), &result); err != nil {
+		t.Fatalf("failed to unmarshal readiness body: %v", err)
+	}
+	return rec.Code, result
+}
+
+// TestReadinessHandler tests the status reported for the dependencies
+func TestReadinessHandler(t *testing.T) {
+	testCases := []struct {
+		name           string
+		ready          int32
+		checkers       []Checker
+		expectedCode   int
+		expectedStatus string
+		expectedChecks map[string]string
+	}{
+		{
+			name:           "all dependencies up",
+			ready:          1,
+			checkers:       []Checker{&countingChecker{name: "arubacloud"}, &countingChecker{name: "arubacloud-auth"}},
+			expectedCode:   http.StatusOK,
+			expectedStatus: StatusReady,
+			expectedChecks: map[string]string{"arubacloud": StatusUp, "arubacloud-auth": StatusUp},
+		},
+		{
+			name:           "one dependency down",
+			ready:          1,
+			checkers:       []Checker{&countingChecker{name: "arubacloud", err: fmt.Errorf("connection refused")}, &countingChecker{name: "arubacloud-auth"}},
+			expectedCode:   http.StatusServiceUnavailable,
+			expectedStatus: StatusNotReady,
+			expectedChecks: map[string]string{"arubacloud": StatusDown, "arubacloud-auth": StatusUp},
+		},
+		{
+			name:           "no dependencies",
+			ready:          1,
+			expectedCode:   http.StatusOK,
+			expectedStatus: StatusReady,
+		},
+		{
+			name:           "shutting down",
+			ready:          0,
+			checkers:       []Checker{&countingChecker{name: "arubacloud"}},
+			expectedCode:   http.StatusServiceUnavailable,
+			expectedStatus: StatusNotReady,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ready := tc.ready
+			code, result := probe(t, ReadinessHandler(&ready, ReadinessOptions{}, tc.checkers...))
+
+			if code != tc.expectedCode {
+				t.Errorf("expected status code %d, got %d", tc.expectedCode, code)
+			}
+			if result.Status != tc.expectedStatus {
+				t.Errorf("expected status '%s', got '%s'", tc.expectedStatus, result.Status)
+			}
+			if len(result.Checks) != len(tc.expectedChecks) {
+				t.Fatalf("expected %d checks, got %d", len(tc.expectedChecks), len(result.Checks))
+			}
+			for name, status := range tc.expectedChecks {
+				if result.Checks[name].Status != status {
+					t.Errorf("expected '%s' to be '%s', got '%s'", name, status, result.Checks[name].Status)
+				}
+			}
+			if down := result.Checks["arubacloud"]; down.Status == StatusDown && down.Error == "" {
+				t.Errorf("expected the error of a failed check to be reported")
+			}
+		})
+	}
+}
+
+// TestReadinessHandler_Cache tests that the result of the checks is reused until it expires
+func TestReadinessHandler_Cache(t *testing.T) {
+	checker := &countingChecker{name: "arubacloud"}
+	rd := &readiness{checkers: []Checker{checker}, opts: ReadinessOptions{CacheTTL: 10 * time.Second, Timeout: time.Second}}
+	now := time.Now()
+	rd.now = func() time.Time { return now }
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rd.check(context.Background())
+		}()
+	}
+	wg.Wait()
+	if got := atomic.LoadInt32(&checker.calls); got != 1 {
+		t.Errorf("expected a single run of the checks, got %d", got)
+	}
+
+	now = now.Add(11 * time.Second)
+	rd.check(context.Background())
+	if got := atomic.LoadInt32(&checker.calls); got != 2 {
+		t.Errorf("expected the checks to run again after expiry, got %d runs", got)
+	}
+}
+
+// TestHTTPChecker tests which responses mark an HTTP dependency as up
+func TestHTTPChecker(t *testing.T) {
+	testCases := []struct {
+		name      string
+		status    int
+		expectErr bool
+	}{
+		{name: "success", status: http.StatusOK},
+		{name: "unauthenticated is up", status: http.StatusUnauthorized},
+		{name: "server error is down", status: http.StatusServiceUnavailable, expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+			}))
+			defer srv.Close()
+
+			err := HTTPChecker("arubacloud", srv.URL, srv.Client()).Check(context.Background())
+			if tc.expectErr && err == nil {
+				t.Errorf("expected an error but got none")
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("did not expect an error but got: %v", err)
+			}
+		})
+	}
+
+	t.Run("unreachable endpoint is down", func(t *testing.T) {
+		srv := httptest.NewServer(http.NotFoundHandler())
+		srv.Close()
+		if err := HTTPChecker("arubacloud", srv.URL, http.DefaultClient).Check(context.Background()); err == nil {
+			t.Errorf("expected an error but got none")
+		}
+	})
+}
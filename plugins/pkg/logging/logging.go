@@ -0,0 +1,150 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/rs/zerolog"
+)
+
+// Log formats selectable with the --log-format flag
+const (
+	FormatConsole = "console"
+	FormatJSON    = "json"
+)
+
+// Names of the fields shared by the plugins, so that log queries work the same for every resource
+const (
+	FieldRequestID      = "requestId"
+	FieldTraceID        = "traceId"
+	FieldMethod         = "method"
+	FieldRoute          = "route"
+	FieldPath           = "path"
+	FieldStatus         = "status"
+	FieldDuration       = "durationMs"
+	FieldOperation      = "operation"
+	FieldUpstreamStatus = "upstreamStatus"
+	FieldUpstreamBody   = "upstreamBody"
+	FieldProjectID      = "projectId"
+	FieldVpcID          = "vpcId"
+	FieldID             = "id"
+)
+
+// Fields are the key-value pairs attached to a log entry
+type Fields map[string]interface{}
+
+// Logger writes structured log entries.
+// Print, Printf and Println are kept for the existing callers and log at info level.
+type Logger interface {
+	Print(v ...interface{})
+	Printf(format string, v ...interface{})
+	Println(v ...interface{})
+
+	Debug(msg string, fields ...Fields)
+	Info(msg string, fields ...Fields)
+	Warn(msg string, fields ...Fields)
+	Error(msg string, err error, fields ...Fields)
+
+	// With returns a logger adding fields to every entry
+	With(fields Fields) Logger
+}
+
+// NewWriter returns the output of the logs in the given format: one JSON object per line,
+// or the human-friendly console writer
+func NewWriter(format string, out io.Writer, noColor bool) (io.Writer, error) {
+	switch strings.ToLower(format) {
+	case FormatJSON:
+		return out, nil
+	case FormatConsole, "":
+		return zerolog.ConsoleWriter{Out: out, NoColor: noColor}, nil
+	}
+	return nil, fmt.Errorf("unsupported log format %q, must be %s or %s", format, FormatConsole, FormatJSON)
+}
+
+// zerologLogger is the Logger backed by zerolog
+type zerologLogger struct {
+	logger zerolog.Logger
+}
+
+var _ Logger = &zerologLogger{}
+
+// New returns a Logger writing to logger. Fields with sensitive names are redacted.
+func New(logger zerolog.Logger) Logger {
+	return &zerologLogger{logger: logger}
+}
+
+func (l *zerologLogger) Print(v ...interface{}) {
+	l.logger.Info().Msg(fmt.Sprint(v...))
+}
+
+func (l *zerologLogger) Printf(format string, v ...interface{}) {
+	l.logger.Info().Msgf(format, v...)
+}
+
+func (l *zerologLogger) Println(v ...interface{}) {
+	l.logger.Info().Msg(strings.TrimSuffix(fmt.Sprintln(v...), "\n"))
+}
+
+func (l *zerologLogger) Debug(msg string, fields ...Fields) {
+	withFields(l.logger.Debug(), fields).Msg(msg)
+}
+
+func (l *zerologLogger) Info(msg string, fields ...Fields) {
+	withFields(l.logger.Info(), fields).Msg(msg)
+}
+
+func (l *zerologLogger) Warn(msg string, fields ...Fields) {
+	withFields(l.logger.Warn(), fields).Msg(msg)
+}
+
+func (l *zerologLogger) Error(msg string, err error, fields ...Fields) {
+	withFields(l.logger.Error().Err(err), fields).Msg(msg)
+}
+
+func (l *zerologLogger) With(fields Fields) Logger {
+	return &zerologLogger{logger: l.logger.With().Fields(map[string]interface{}(redactFields(fields))).Logger()}
+}
+
+func withFields(e *zerolog.Event, fields []Fields) *zerolog.Event {
+	for _, f := range fields {
+		// zerolog only accepts the unnamed map type
+		e = e.Fields(map[string]interface{}(redactFields(f)))
+	}
+	return e
+}
+
+type loggerKey struct{}
+
+// WithLogger returns a copy of ctx carrying logger
+func WithLogger(ctx context.Context, logger Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// FromContext returns the logger carried by ctx, typically scoped to the request by Middleware,
+// fallback if none
+func FromContext(ctx context.Context, fallback Logger) Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(Logger); ok {
+		return logger
+	}
+	return fallback
+}
+
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the request ID
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID carried by ctx, empty if none
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// Discard returns a logger dropping every entry, useful in tests
+func Discard() Logger {
+	return New(zerolog.New(io.Discard))
+}
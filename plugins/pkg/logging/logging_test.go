@@ -0,0 +1,268 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// newJSONLogger returns a Logger writing JSON lines to buf
+func newJSONLogger(buf *bytes.Buffer) Logger {
+	w, _ := NewWriter(FormatJSON, buf, true)
+	return New(zerolog.New(w))
+}
+
+// entries decodes the JSON lines written to buf
+func entries(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+	var result []map[string]interface{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("failed to unmarshal log line '%s': %v", line, err)
+		}
+		result = append(result, entry)
+	}
+	return result
+}
+
+// TestLogger tests that fields are attached to the entries and sensitive ones are redacted
+func TestLogger(t *testing.T) {
+	var buf bytes.Buffer
+	log := newJSONLogger(&buf).With(Fields{FieldRequestID: "req-1", "Authorization": "Bearer abc"})
+	log.Warn("Aruba Cloud API returned non-200 status", Fields{FieldUpstreamStatus: 404, "clientSecret": "s3cr3t"})
+
+	if strings.Contains(buf.String(), "abc") || strings.Contains(buf.String(), "s3cr3t") {
+		t.Fatalf("expected sensitive values to be redacted, got: %s", buf.String())
+	}
+
+	entry := entries(t, &buf)[0]
+	expected := map[string]interface{}{
+		"level":             "warn",
+		"message":           "Aruba Cloud API returned non-200 status",
+		FieldRequestID:      "req-1",
+		FieldUpstreamStatus: float64(404),
+		"Authorization":     Redacted,
+		"clientSecret":      Redacted,
+	}
+	for k, v := range expected {
+		if entry[k] != v {
+			t.Errorf("expected field '%s' to be '%v', got '%v'", k, v, entry[k])
+		}
+	}
+}
+
+// TestNewWriter tests the selection of the log format
+func TestNewWriter(t *testing.T) {
+	testCases := []struct {
+		format    string
+		expectErr bool
+	}{
+		{format: "json"},
+		{format: "JSON"},
+		{format: "console"},
+		{format: ""},
+		{format: "logfmt", expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format, func(t *testing.T) {
+			_, err := NewWriter(tc.format, &bytes.Buffer{}, true)
+			if tc.expectErr && err == nil {
+				t.Errorf("expected an error but got none")
+			}
+			if !tc.expectErr && err != nil {
+				t.Errorf("did not expect an error but got: %v", err)
+			}
+		})
+	}
+}
+
+// TestRedactBody tests the redaction of the bodies written to the logs
+func TestRedactBody(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "nested sensitive properties",
+			body:     `{"metadata":{"name":"db"},"properties":{"adminPassword":"p4ss","users":[{"name":"u","apiKey":"k"}]}}`,
+			expected: `{"metadata":{"name":"db"},"properties":{"adminPassword":"[REDACTED]","users":[{"apiKey":"[REDACTED]","name":"u"}]}}`,
+		},
+		{
+			name:     "pre-shared keys",
+			body:     `{"properties":{"peerAddress":"203.0.113.10","ike":{"preSharedKey":"k3y"},"pskSecretRef":{"name":"vpn"}}}`,
+			expected: `{"properties":{"ike":{"preSharedKey":"[REDACTED]"},"peerAddress":"203.0.113.10","pskSecretRef":"[REDACTED]"}}`,
+		},
+		{
+			name:     "key material",
+			body:     `{"properties":{"algorithm":"AES256","keyMaterial":"c2VjcmV0","public_key_material":"cHVi"}}`,
+			expected: `{"properties":{"algorithm":"AES256","keyMaterial":"[REDACTED]","public_key_material":"[REDACTED]"}}`,
+		},
+		{
+			name:     "problem details are kept",
+			body:     `{"title":"Not Found","status":404}`,
+			expected: `{"title":"Not Found","status":404}`,
+		},
+		{
+			name:     "non-JSON body",
+			body:     `password=p4ss`,
+			expected: `[non-JSON body of 13 bytes]`,
+		},
+		{
+			name:     "empty body",
+			body:     ``,
+			expected: ``,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := RedactBody([]byte(tc.body)); got != tc.expected {
+				t.Errorf("expected '%s', got '%s'", tc.expected, got)
+			}
+		})
+	}
+
+	long := `{"description":"` + strings.Repeat("a", 2*MaxBodyLength) + `"}`
+	if got := RedactBody([]byte(long)); !strings.HasSuffix(got, "...(truncated)") {
+		t.Errorf("expected long bodies to be truncated")
+	}
+}
+
+// TestRedactJSON tests the redaction of the bodies returned to the callers, which are never truncated
+func TestRedactJSON(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "echoed request",
+			body:     `{"title":"Bad Request","detail":"invalid user","request":{"name":"u","password":"p4ss"}}`,
+			expected: `{"detail":"invalid user","request":{"name":"u","password":"[REDACTED]"},"title":"Bad Request"}`,
+		},
+		{
+			name:     "secret echoed in a string",
+			body:     `{"title":"Bad Request","errors":["preSharedKey 'k3y' is too short"]}`,
+			expected: `{"errors":["preSharedKey [REDACTED] is too short"],"title":"Bad Request"}`,
+		},
+		{
+			name:     "nothing to redact",
+			body:     `{"title":"Not Found", "status":404}`,
+			expected: `{"title":"Not Found", "status":404}`,
+		},
+		{
+			name:     "long body",
+			body:     `{"description":"` + strings.Repeat("a", 2*MaxBodyLength) + `","token":"t"}`,
+			expected: `{"description":"` + strings.Repeat("a", 2*MaxBodyLength) + `","token":"[REDACTED]"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := RedactJSON([]byte(tc.body))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tc.expected {
+				t.Errorf("expected '%s', got '%s'", tc.expected, got)
+			}
+		})
+	}
+
+	if _, err := RedactJSON([]byte(`password=p4ss`)); err == nil {
+		t.Errorf("expected an error for a non-JSON body")
+	}
+}
+
+// TestRedactText tests the redaction of the values assigned to sensitive names in free text
+func TestRedactText(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{name: "assignment", text: "adminPassword=p4ss is too weak", expected: "adminPassword=[REDACTED] is too weak"},
+		{name: "JSON property", text: `invalid request {"password": "p4ss", "name": "u"}`, expected: `invalid request {"password": [REDACTED], "name": "u"}`},
+		{name: "quoted value", text: "pre-shared key psk 'k3y' rejected", expected: "pre-shared key psk [REDACTED] rejected"},
+		{name: "bearer token", text: "Authorization: Bearer abc.def rejected", expected: "Authorization: [REDACTED] rejected"},
+		{name: "nothing to redact", text: "volume v1 not found", expected: "volume v1 not found"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := RedactText(tc.text); got != tc.expected {
+				t.Errorf("expected '%s', got '%s'", tc.expected, got)
+			}
+		})
+	}
+}
+
+// TestMiddleware tests the assignment and propagation of request IDs
+func TestMiddleware(t *testing.T) {
+	testCases := []struct {
+		name       string
+		incoming   string
+		expectKept bool
+	}{
+		{name: "incoming ID is kept", incoming: "controller-42", expectKept: true},
+		{name: "missing ID is generated"},
+		{name: "ID with control characters is replaced", incoming: "a\nlevel=error"},
+		{name: "overlong ID is replaced", incoming: strings.Repeat("x", maxRequestIDLength+1)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			var seenID string
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /projects/{projectId}", func(w http.ResponseWriter, r *http.Request) {
+				seenID = RequestID(r.Context())
+				FromContext(r.Context(), nil).Info("handled")
+				w.WriteHeader(http.StatusAccepted)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/projects/p1", nil)
+			if tc.incoming != "" {
+				req.Header.Set(RequestIDHeader, tc.incoming)
+			}
+			rec := httptest.NewRecorder()
+			Middleware(newJSONLogger(&buf), mux).ServeHTTP(rec, req)
+
+			id := rec.Header().Get(RequestIDHeader)
+			if id == "" || id != seenID {
+				t.Fatalf("expected the response header '%s' to match the context ID '%s'", id, seenID)
+			}
+			if tc.expectKept && id != tc.incoming {
+				t.Errorf("expected the incoming ID '%s' to be kept, got '%s'", tc.incoming, id)
+			}
+			if !tc.expectKept && id == tc.incoming {
+				t.Errorf("expected the incoming ID to be replaced")
+			}
+
+			logged := entries(t, &buf)
+			if len(logged) != 2 {
+				t.Fatalf("expected 2 log entries, got %d", len(logged))
+			}
+			for _, entry := range logged {
+				if entry[FieldRequestID] != id {
+					t.Errorf("expected every entry to carry the request ID, got %v", entry)
+				}
+			}
+			summary := logged[1]
+			if summary[FieldRoute] != "GET /projects/{projectId}" || summary[FieldStatus] != float64(http.StatusAccepted) {
+				t.Errorf("unexpected request summary: %v", summary)
+			}
+		})
+	}
+}
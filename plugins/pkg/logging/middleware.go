@@ -0,0 +1,101 @@
+package logging
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RequestIDHeader carries the ID correlating the logs of a request, from the caller to Aruba Cloud
+const RequestIDHeader = "X-Request-Id"
+
+// maxRequestIDLength bounds the length of an incoming request ID, longer ones are replaced
+const maxRequestIDLength = 128
+
+// quietRoutes are logged at debug level on success, since probes and scrapes would flood the logs
+var quietRoutes = map[string]bool{
+	"GET /healthz": true,
+	"GET /readyz":  true,
+	"GET /metrics": true,
+}
+
+// statusRecorder captures the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// Middleware assigns a request ID to every request, keeping the one in the X-Request-Id header when valid,
+// and returns it in the response header. The request context carries the ID and a logger scoped to the request,
+// retrieved with RequestID and FromContext. A summary of each request is logged once it has been served.
+func Middleware(logger Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if !validRequestID(id) {
+			id = newRequestID()
+		}
+		w.Header().Set(RequestIDHeader, id)
+
+		fields := Fields{FieldRequestID: id}
+		if sc := trace.SpanContextFromContext(r.Context()); sc.HasTraceID() {
+			fields[FieldTraceID] = sc.TraceID().String()
+		}
+		reqLogger := logger.With(fields)
+
+		ctx := WithLogger(WithRequestID(r.Context(), id), reqLogger)
+		r = r.WithContext(ctx)
+
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+
+		if rec.status == 0 {
+			rec.status = http.StatusOK
+		}
+		summary := Fields{
+			FieldMethod:   r.Method,
+			FieldRoute:    r.Pattern,
+			FieldPath:     r.URL.Path,
+			FieldStatus:   rec.status,
+			FieldDuration: time.Since(start).Milliseconds(),
+		}
+		if quietRoutes[r.Pattern] && rec.status < http.StatusBadRequest {
+			reqLogger.Debug("request served", summary)
+			return
+		}
+		reqLogger.Info("request served", summary)
+	})
+}
+
+// validRequestID accepts IDs made of printable ASCII characters, so that a caller cannot forge log lines
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,152 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces the values that must never reach the logs
+const Redacted = "[REDACTED]"
+
+// MaxBodyLength bounds the length of a body written to the logs
+const MaxBodyLength = 2048
+
+// sensitiveKeys are matched, case-insensitively, as substrings of field, header and JSON property names
+var sensitiveKeys = []string{
+	"authorization",
+	"password",
+	"passphrase",
+	"secret",
+	"token",
+	"apikey",
+	"api_key",
+	"api-key",
+	"privatekey",
+	"private_key",
+	"credential",
+	"cookie",
+	"presharedkey",
+	"psk",
+	"keymaterial",
+	"key_material",
+}
+
+// sensitiveAssignment matches a sensitive name assigned a value in a text, e.g. password=p4ss or "token": "t",
+// sensitiveQuoted a sensitive name followed by a quoted value, e.g. password 'p4ss'
+var (
+	sensitiveName       = `[\w.-]*(?:` + strings.Join(quoteAll(sensitiveKeys), "|") + `)[\w.-]*["']?`
+	sensitiveAssignment = regexp.MustCompile(`(?i)(` + sensitiveName + `\s*[:=]\s*)(?:"[^"]*"|'[^']*'|(?:bearer\s+)?[^\s,;&"'}\]]+)`)
+	sensitiveQuoted     = regexp.MustCompile(`(?i)(` + sensitiveName + `\s+)(?:"[^"]*"|'[^']*')`)
+)
+
+func quoteAll(keys []string) []string {
+	quoted := make([]string, len(keys))
+	for i, key := range keys {
+		quoted[i] = regexp.QuoteMeta(key)
+	}
+	return quoted
+}
+
+// IsSensitive reports whether the value of a field, header or JSON property called name must be redacted
+func IsSensitive(name string) bool {
+	name = strings.ToLower(name)
+	for _, key := range sensitiveKeys {
+		if strings.Contains(name, key) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactFields(fields Fields) Fields {
+	redacted := make(Fields, len(fields))
+	for k, v := range fields {
+		if IsSensitive(k) {
+			v = Redacted
+		}
+		redacted[k] = v
+	}
+	return redacted
+}
+
+// RedactBody returns a JSON body safe to be logged: the values of the sensitive properties, at any depth,
+// are replaced and the result is truncated to MaxBodyLength.
+// A body that is not JSON cannot be inspected, so only its length is reported.
+func RedactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	redacted, err := RedactJSON(body)
+	if err != nil {
+		return fmt.Sprintf("[non-JSON body of %d bytes]", len(body))
+	}
+
+	if len(redacted) > MaxBodyLength {
+		return string(redacted[:MaxBodyLength]) + "...(truncated)"
+	}
+	return string(redacted)
+}
+
+// RedactText returns text with the values assigned to sensitive names replaced, e.g. in the detail of an Aruba Cloud error
+// echoing a request: "password=p4ss is too weak" becomes "password=[REDACTED] is too weak"
+func RedactText(text string) string {
+	text = sensitiveAssignment.ReplaceAllString(text, "${1}"+Redacted)
+	return sensitiveQuoted.ReplaceAllString(text, "${1}"+Redacted)
+}
+
+// RedactJSON returns body with the values of the sensitive properties, at any depth, replaced,
+// as well as the sensitive values found by RedactText in the strings,
+// e.g. before an Aruba Cloud error echoing a request is returned to the caller.
+// A body without sensitive properties is returned as is, an error is returned when body is not JSON.
+func RedactJSON(body []byte) ([]byte, error) {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return nil, err
+	}
+	if !redactValue(v) {
+		return body, nil
+	}
+	return json.Marshal(v)
+}
+
+// redactValue replaces in place the sensitive values of v and reports whether any was found
+func redactValue(v interface{}) bool {
+	redacted := false
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, child := range t {
+			if IsSensitive(k) {
+				t[k] = Redacted
+				redacted = true
+				continue
+			}
+			if text, ok := child.(string); ok {
+				if r := RedactText(text); r != text {
+					t[k] = r
+					redacted = true
+				}
+				continue
+			}
+			if redactValue(child) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for i, child := range t {
+			if text, ok := child.(string); ok {
+				if r := RedactText(text); r != text {
+					t[i] = r
+					redacted = true
+				}
+				continue
+			}
+			if redactValue(child) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
@@ -0,0 +1,200 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Namespace prefixes the name of every metric exposed by the plugins
+const Namespace = "arubacloud_plugin"
+
+// Labels used when the value is not known
+const (
+	UnmatchedRoute   = "unmatched"
+	UnknownOperation = "unknown"
+)
+
+// HTTPClient is the subset of *http.Client used for Aruba Cloud calls.
+// It mirrors handlers.HTTPClient so that handlers can use this package without an import cycle.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Metrics holds the Prometheus collectors of a plugin, registered on a dedicated registry
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests         *prometheus.CounterVec
+	requestDuration  *prometheus.HistogramVec
+	requestsInFlight prometheus.Gauge
+
+	upstreamRequests         *prometheus.CounterVec
+	upstreamDuration         *prometheus.HistogramVec
+	upstreamRequestsInFlight *prometheus.GaugeVec
+}
+
+// New returns the plugin metrics, together with the Go runtime and process collectors
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "http_requests_total",
+			Help:      "Number of HTTP requests served by the plugin, by route, method and status code.",
+		}, []string{"route", "method", "code"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "Latency of the HTTP requests served by the plugin, by route and method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route", "method"}),
+		requestsInFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "http_requests_in_flight",
+			Help:      "Number of HTTP requests currently served by the plugin.",
+		}),
+		upstreamRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "upstream_requests_total",
+			Help:      "Number of calls to the Aruba Cloud API, by operation, method and status code. Every retry attempt is counted; transport errors have code \"error\".",
+		}, []string{"operation", "method", "code"}),
+		upstreamDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "upstream_request_duration_seconds",
+			Help:      "Latency of the calls to the Aruba Cloud API, by operation and method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation", "method"}),
+		upstreamRequestsInFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "upstream_requests_in_flight",
+			Help:      "Number of calls to the Aruba Cloud API currently in progress, by operation.",
+		}, []string{"operation"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.requests,
+		m.requestDuration,
+		m.requestsInFlight,
+		m.upstreamRequests,
+		m.upstreamDuration,
+		m.upstreamRequestsInFlight,
+	)
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// statusRecorder captures the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// Middleware records count and latency of the requests served by next, which must be a *http.ServeMux
+// (or wrap one) so that the route label is the matched pattern rather than the path, keeping the
+// cardinality bounded regardless of project, VPC and resource IDs
+func (m *Metrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.requestsInFlight.Inc()
+		defer m.requestsInFlight.Dec()
+
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+
+		// The ServeMux sets the pattern on the request once it has been routed
+		route := routeOf(r.Pattern)
+		if rec.status == 0 {
+			rec.status = http.StatusOK
+		}
+		m.requests.WithLabelValues(route, r.Method, strconv.Itoa(rec.status)).Inc()
+		m.requestDuration.WithLabelValues(route, r.Method).Observe(time.Since(start).Seconds())
+	})
+}
+
+// routeOf strips the method from a ServeMux pattern, since it is reported in its own label
+func routeOf(pattern string) string {
+	if pattern == "" {
+		return UnmatchedRoute
+	}
+	if i := strings.IndexByte(pattern, ' '); i >= 0 {
+		return pattern[i+1:]
+	}
+	return pattern
+}
+
+type operationKey struct{}
+
+// WithOperation returns a copy of ctx carrying the name of the Aruba Cloud operation (e.g. "get_subnet"),
+// used to label the metrics of the upstream calls made with it
+func WithOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// Operation returns the operation carried by ctx, UnknownOperation if none
+func Operation(ctx context.Context) string {
+	if op, ok := ctx.Value(operationKey{}).(string); ok && op != "" {
+		return op
+	}
+	return UnknownOperation
+}
+
+// instrumentedClient records count and latency of the calls made with the wrapped client
+type instrumentedClient struct {
+	client  HTTPClient
+	metrics *Metrics
+}
+
+// InstrumentClient wraps client so that every call is recorded, labeled with the operation set
+// on the request context with WithOperation
+func (m *Metrics) InstrumentClient(client HTTPClient) HTTPClient {
+	return &instrumentedClient{client: client, metrics: m}
+}
+
+func (c *instrumentedClient) Do(req *http.Request) (*http.Response, error) {
+	op := Operation(req.Context())
+	inFlight := c.metrics.upstreamRequestsInFlight.WithLabelValues(op)
+	inFlight.Inc()
+	defer inFlight.Dec()
+
+	start := time.Now()
+	resp, err := c.client.Do(req)
+	c.metrics.upstreamDuration.WithLabelValues(op, req.Method).Observe(time.Since(start).Seconds())
+
+	code := "error"
+	if err == nil {
+		code = strconv.Itoa(resp.StatusCode)
+	}
+	c.metrics.upstreamRequests.WithLabelValues(op, req.Method, code).Inc()
+	return resp, err
+}
@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+type fakeClient struct {
+	status int
+	err    error
+}
+
+func (f *fakeClient) Do(req *http.Request) (*http.Response, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &http.Response{StatusCode: f.status, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+}
+
+// TestMiddleware tests that requests are labeled with the matched route instead of the path
+func TestMiddleware(t *testing.T) {
+	m := New()
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /projects/{projectId}/subnets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("POST /projects/{projectId}/subnets", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("{}"))
+	})
+	h := m.Middleware(mux)
+
+	for _, path := range []string{"/projects/p1/subnets/s1", "/projects/p2/subnets/s2"} {
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/projects/p1/subnets", nil))
+	h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/nowhere", nil))
+
+	testCases := []struct {
+		labels   []string
+		expected float64
+	}{
+		{labels: []string{"/projects/{projectId}/subnets/{id}", "GET", "404"}, expected: 2},
+		{labels: []string{"/projects/{projectId}/subnets", "POST", "200"}, expected: 1},
+		{labels: []string{UnmatchedRoute, "GET", "404"}, expected: 1},
+	}
+	for _, tc := range testCases {
+		if got := testutil.ToFloat64(m.requests.WithLabelValues(tc.labels...)); got != tc.expected {
+			t.Errorf("expected %v requests for %v, got %v", tc.expected, tc.labels, got)
+		}
+	}
+	if got := testutil.CollectAndCount(m.requests); got != len(testCases) {
+		t.Errorf("expected %d series, got %d", len(testCases), got)
+	}
+	if got := testutil.ToFloat64(m.requestsInFlight); got != 0 {
+		t.Errorf("expected no requests in flight, got %v", got)
+	}
+}
+
+// TestInstrumentClient tests the labels of the upstream calls
+func TestInstrumentClient(t *testing.T) {
+	m := New()
+
+	ok := m.InstrumentClient(&fakeClient{status: http.StatusOK})
+	req, _ := http.NewRequestWithContext(WithOperation(context.Background(), "get_subnet"), http.MethodGet, "http://aruba.test", nil)
+	ok.Do(req)
+	ok.Do(req)
+
+	failing := m.InstrumentClient(&fakeClient{err: fmt.Errorf("connection reset")})
+	req, _ = http.NewRequest(http.MethodDelete, "http://aruba.test", nil)
+	if _, err := failing.Do(req); err == nil {
+		t.Fatalf("expected an error but got none")
+	}
+
+	if got := testutil.ToFloat64(m.upstreamRequests.WithLabelValues("get_subnet", "GET", "200")); got != 2 {
+		t.Errorf("expected 2 get_subnet calls, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.upstreamRequests.WithLabelValues(UnknownOperation, "DELETE", "error")); got != 1 {
+		t.Errorf("expected 1 failed call without operation, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.upstreamRequestsInFlight.WithLabelValues("get_subnet")); got != 0 {
+		t.Errorf("expected no calls in flight, got %v", got)
+	}
+}
+
+// TestHandler tests that the metrics are served in the Prometheus format
+func TestHandler(t *testing.T) {
+	m := New()
+	m.upstreamRequests.WithLabelValues("list_subnets", "GET", "200").Inc()
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+	expected := `arubacloud_plugin_upstream_requests_total{code="200",method="GET",operation="list_subnets"} 1`
+	if !strings.Contains(rec.Body.String(), expected) {
+		t.Errorf("expected metrics to contain '%s'", expected)
+	}
+	if !strings.Contains(rec.Body.String(), "go_goroutines") {
+		t.Errorf("expected Go runtime metrics")
+	}
+}
@@ -0,0 +1,189 @@
+package secrets
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// Files mounted in every pod with the credentials of its service account
+	DefaultTokenPath     = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	DefaultCAPath        = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+	DefaultNamespacePath = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+	// Environment variables set in every pod with the address of the Kubernetes API
+	ServiceHostEnv = "KUBERNETES_SERVICE_HOST"
+	ServicePortEnv = "KUBERNETES_SERVICE_PORT"
+)
+
+// ErrNotFound is returned when the referenced Secret or key does not exist
+var ErrNotFound = errors.New("not found")
+
+// ErrNamespaceNotAllowed is returned when the referenced Secret is outside the namespaces the plugin may read from
+var ErrNamespaceNotAllowed = errors.New("namespace not allowed")
+
+// Ref references a key of a Kubernetes Secret, e.g. the password of a database user.
+// It is used in the request bodies in place of the value, which is then never part of a custom resource.
+type Ref struct {
+	// Name of the Secret.
+	Name string `json:"name,omitempty"`
+	// Namespace of the Secret.
+	Namespace string `json:"namespace,omitempty"`
+	// Key of the value in the Secret.
+	Key string `json:"key,omitempty"`
+} // @name SecretRef
+
+// String returns the reference in messages, never the value
+func (ref Ref) String() string {
+	return fmt.Sprintf("%s/%s key %s", ref.Namespace, ref.Name, ref.Key)
+}
+
+// Validate checks that the reference at field is complete
+func (ref *Ref) Validate(field string) error {
+	if ref == nil {
+		return fmt.Errorf("%s is required", field)
+	}
+	if ref.Name == "" || ref.Namespace == "" || ref.Key == "" {
+		return fmt.Errorf("%s must set name, namespace and key", field)
+	}
+	return nil
+}
+
+// Resolver returns the values of the referenced Secret keys
+type Resolver interface {
+	Resolve(ctx context.Context, ref Ref) (string, error)
+}
+
+// Doer is the subset of *http.Client used to call the Kubernetes API
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Options configures a KubernetesResolver
+type Options struct {
+	Host              string   // Kubernetes API URL, e.g. https://10.0.0.1:443
+	TokenPath         string   // file with the service account token, DefaultTokenPath if empty
+	Client            Doer     // HTTP client trusting the Kubernetes API, http.DefaultClient if nil
+	AllowedNamespaces []string // namespaces the Secrets can be read from, none if empty
+}
+
+// KubernetesResolver reads the Secrets from the Kubernetes API with the service account of the plugin,
+// which must be allowed to get them. The callers of the plugin are not authenticated, so only the Secrets
+// of the allowed namespaces are read, whatever the permissions of the service account.
+type KubernetesResolver struct {
+	host              string
+	tokenPath         string
+	client            Doer
+	allowedNamespaces []string
+}
+
+// NewKubernetesResolver returns a resolver reading the Secrets with the given options
+func NewKubernetesResolver(opts Options) *KubernetesResolver {
+	r := &KubernetesResolver{host: strings.TrimRight(opts.Host, "/"), tokenPath: opts.TokenPath, client: opts.Client, allowedNamespaces: opts.AllowedNamespaces}
+	if r.tokenPath == "" {
+		r.tokenPath = DefaultTokenPath
+	}
+	if r.client == nil {
+		r.client = http.DefaultClient
+	}
+	return r
+}
+
+// InCluster reports whether the plugin runs in a Kubernetes pod
+func InCluster() bool {
+	return os.Getenv(ServiceHostEnv) != "" && os.Getenv(ServicePortEnv) != ""
+}
+
+// InClusterOptions returns the options reaching the Kubernetes API of the pod the plugin runs in,
+// allowed to read the Secrets of the namespace of the pod only
+func InClusterOptions() (Options, error) {
+	if !InCluster() {
+		return Options{}, fmt.Errorf("%s and %s must be set to reach the Kubernetes API", ServiceHostEnv, ServicePortEnv)
+	}
+	ca, err := os.ReadFile(DefaultCAPath)
+	if err != nil {
+		return Options{}, fmt.Errorf("failed to read the Kubernetes API CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return Options{}, fmt.Errorf("no certificate found in %s", DefaultCAPath)
+	}
+	namespace, err := os.ReadFile(DefaultNamespacePath)
+	if err != nil {
+		return Options{}, fmt.Errorf("failed to read the namespace of the pod: %w", err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	return Options{
+		Host:              "https://" + net.JoinHostPort(os.Getenv(ServiceHostEnv), os.Getenv(ServicePortEnv)),
+		TokenPath:         DefaultTokenPath,
+		Client:            &http.Client{Transport: transport, Timeout: 10 * time.Second},
+		AllowedNamespaces: []string{strings.TrimSpace(string(namespace))},
+	}, nil
+}
+
+// Resolve returns the value of the referenced key. The token is read on every call, since it is rotated by the kubelet.
+// References outside the allowed namespaces are rejected without calling the Kubernetes API.
+func (r *KubernetesResolver) Resolve(ctx context.Context, ref Ref) (string, error) {
+	if !slices.Contains(r.allowedNamespaces, ref.Namespace) {
+		return "", fmt.Errorf("secret %s/%s %w: the plugin can only read the Secrets of the namespaces %s", ref.Namespace, ref.Name, ErrNamespaceNotAllowed, strings.Join(r.allowedNamespaces, ", "))
+	}
+
+	token, err := os.ReadFile(r.tokenPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read service account token: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/api/v1/namespaces/%s/secrets/%s", r.host, url.PathEscape(ref.Namespace), url.PathEscape(ref.Name))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(token)))
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get secret %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	defer resp.Body.Close()
+
+	// The body of a Secret is never part of the errors
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return "", fmt.Errorf("secret %s/%s %w", ref.Namespace, ref.Name, ErrNotFound)
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return "", fmt.Errorf("not allowed to get secret %s/%s, status %d: check the RBAC of the plugin service account", ref.Namespace, ref.Name, resp.StatusCode)
+	case resp.StatusCode != http.StatusOK:
+		return "", fmt.Errorf("failed to get secret %s/%s, status %d", ref.Namespace, ref.Name, resp.StatusCode)
+	}
+
+	var secret struct {
+		Data map[string][]byte `json:"data"`
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return "", fmt.Errorf("failed to decode secret %s/%s", ref.Namespace, ref.Name)
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("%s %w", ref, ErrNotFound)
+	}
+	return string(value), nil
+}
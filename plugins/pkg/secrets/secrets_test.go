@@ -0,0 +1,143 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newAPIServer returns a Kubernetes API serving the Secret default/db with the key password
+func newAPIServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer sa-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/namespaces/default/secrets/db":
+			// "czNjcjN0" is "s3cr3t" base64 encoded
+			w.Write([]byte(`{"kind":"Secret","data":{"password":"czNjcjN0"}}`))
+		case "/api/v1/namespaces/restricted/secrets/db":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func writeToken(t *testing.T, token string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte(token+"\n"), 0o600); err != nil {
+		t.Fatalf("failed to write token: %v", err)
+	}
+	return path
+}
+
+func TestKubernetesResolver(t *testing.T) {
+	srv := newAPIServer(t)
+	r := NewKubernetesResolver(Options{Host: srv.URL + "/", TokenPath: writeToken(t, "sa-token"), Client: srv.Client(), AllowedNamespaces: []string{"default", "restricted"}})
+
+	testCases := []struct {
+		name          string
+		ref           Ref
+		expectedValue string
+		expectedError string
+		notFound      bool
+	}{
+		{name: "value", ref: Ref{Name: "db", Namespace: "default", Key: "password"}, expectedValue: "s3cr3t"},
+		{name: "missing secret", ref: Ref{Name: "other", Namespace: "default", Key: "password"}, expectedError: "secret default/other not found", notFound: true},
+		{name: "missing key", ref: Ref{Name: "db", Namespace: "default", Key: "token"}, expectedError: "default/db key token not found", notFound: true},
+		{name: "forbidden", ref: Ref{Name: "db", Namespace: "restricted", Key: "password"}, expectedError: "not allowed to get secret restricted/db"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := r.Resolve(context.Background(), tc.ref)
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if value != tc.expectedValue {
+					t.Errorf("expected value '%s', got '%s'", tc.expectedValue, value)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Fatalf("expected error containing '%s', got %v", tc.expectedError, err)
+			}
+			if errors.Is(err, ErrNotFound) != tc.notFound {
+				t.Errorf("expected errors.Is(err, ErrNotFound) to be %t, got %v", tc.notFound, err)
+			}
+		})
+	}
+}
+
+func TestKubernetesResolver_TokenRotation(t *testing.T) {
+	srv := newAPIServer(t)
+	path := writeToken(t, "old-token")
+	r := NewKubernetesResolver(Options{Host: srv.URL, TokenPath: path, Client: srv.Client(), AllowedNamespaces: []string{"default"}})
+	ref := Ref{Name: "db", Namespace: "default", Key: "password"}
+
+	if _, err := r.Resolve(context.Background(), ref); err == nil {
+		t.Fatal("expected the old token to be rejected")
+	}
+	if err := os.WriteFile(path, []byte("sa-token"), 0o600); err != nil {
+		t.Fatalf("failed to rotate token: %v", err)
+	}
+	if _, err := r.Resolve(context.Background(), ref); err != nil {
+		t.Errorf("expected the rotated token to be used, got %v", err)
+	}
+}
+
+func TestKubernetesResolver_AllowedNamespaces(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("did not expect calls to the Kubernetes API, got %s", r.URL.Path)
+	}))
+	t.Cleanup(srv.Close)
+
+	testCases := []struct {
+		name              string
+		allowedNamespaces []string
+		ref               Ref
+		expectedError     string
+	}{
+		{name: "other namespace", allowedNamespaces: []string{"krateo-system"}, ref: Ref{Name: "db", Namespace: "kube-system", Key: "password"}, expectedError: "secret kube-system/db namespace not allowed: the plugin can only read the Secrets of the namespaces krateo-system"},
+		{name: "no allowed namespace", ref: Ref{Name: "db", Namespace: "default", Key: "password"}, expectedError: "secret default/db namespace not allowed"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewKubernetesResolver(Options{Host: srv.URL, TokenPath: writeToken(t, "sa-token"), Client: srv.Client(), AllowedNamespaces: tc.allowedNamespaces})
+
+			_, err := r.Resolve(context.Background(), tc.ref)
+			if err == nil || !strings.HasPrefix(err.Error(), tc.expectedError) {
+				t.Fatalf("expected error starting with '%s', got %v", tc.expectedError, err)
+			}
+			if !errors.Is(err, ErrNamespaceNotAllowed) {
+				t.Errorf("expected errors.Is(err, ErrNamespaceNotAllowed), got %v", err)
+			}
+		})
+	}
+}
+
+func TestRef_Validate(t *testing.T) {
+	var missing *Ref
+	if err := missing.Validate("passwordSecretRef"); err == nil || err.Error() != "passwordSecretRef is required" {
+		t.Errorf("expected a required error, got %v", err)
+	}
+	if err := (&Ref{Name: "db", Namespace: "default"}).Validate("passwordSecretRef"); err == nil {
+		t.Error("expected an error without key")
+	}
+	if err := (&Ref{Name: "db", Namespace: "default", Key: "password"}).Validate("passwordSecretRef"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
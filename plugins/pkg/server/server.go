@@ -6,28 +6,79 @@ import (
 	"flag"
 	"fmt"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
+	"strings"
+	"sync"
 	"sync/atomic"
 	"syscall"
 	"time"
 
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/auth"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/handlers"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/health"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/logging"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/metrics"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/secrets"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/tracing"
 	"github.com/krateoplatformops/plumbing/env"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
+// DefaultBaseURL is the public Aruba Cloud API endpoint
+const DefaultBaseURL = "https://api.arubacloud.com"
+
+// writeTimeout bounds the time to serve a request, upstream timeouts should stay below it
+const writeTimeout = 50 * time.Second
+
 type Server struct {
 	*http.Server
-	mux     *http.ServeMux
-	healthy int32
-	ready   int32
+	mux      *http.ServeMux
+	baseURL  string
+	auth     auth.TokenProvider
+	secrets  secretsSetup
+	client   handlers.HTTPClient
+	logger   logging.Logger
+	timeouts handlers.UpstreamTimeouts
+	metrics  *metrics.Metrics
+	healthy  int32
+	ready    int32
+
+	readinessOpts     health.ReadinessOptions
+	readinessCheckers []health.Checker
+
+	shutdownTracing func(context.Context) error
+}
+
+// secretsSetup builds the Secret resolver once, on the first call of SecretResolver
+type secretsSetup struct {
+	once              sync.Once
+	allowedNamespaces []string
+	resolver          secrets.Resolver
 }
 
 func New() *Server {
 	debugOn := flag.Bool("debug", env.Bool("DEBUG", true), "dump verbose output")
 	port := flag.Int("port", env.Int("PORT", 8080), "port to listen on")
 	noColor := flag.Bool("no-color", env.Bool("NO_COLOR", false), "disable color output")
+	logFormat := flag.String("log-format", env.String("LOG_FORMAT", logging.FormatConsole), "log output format, console or json")
+	baseURL := flag.String("aruba-base-url", env.String("ARUBA_BASE_URL", DefaultBaseURL), "base URL of the Aruba Cloud API")
+	tokenURL := flag.String("auth-token-url", env.String("ARUBA_TOKEN_URL", auth.DefaultTokenURL), "token endpoint used to obtain access tokens with client credentials")
+	credentialsPath := flag.String("auth-credentials-path", env.String("ARUBA_CREDENTIALS_PATH", ""), "directory containing the client-id and client-secret files (e.g. a mounted Kubernetes Secret)")
+	clientTimeout := flag.Duration("http-client-timeout", env.Duration("HTTP_CLIENT_TIMEOUT", 30*time.Second), "timeout of a single attempt of a call to Aruba Cloud")
+	retryMaxAttempts := flag.Int("retry-max-attempts", env.Int("RETRY_MAX_ATTEMPTS", handlers.DefaultRetryMaxAttempts), "maximum number of attempts of a call to Aruba Cloud, 1 disables retries")
+	retryInitialBackoff := flag.Duration("retry-initial-backoff", env.Duration("RETRY_INITIAL_BACKOFF", handlers.DefaultRetryInitialBackoff), "upper bound of the wait before the first retry")
+	retryMaxBackoff := flag.Duration("retry-max-backoff", env.Duration("RETRY_MAX_BACKOFF", handlers.DefaultRetryMaxBackoff), "upper bound of the wait between retries, including Retry-After")
+	readinessCacheTTL := flag.Duration("readiness-cache-ttl", env.Duration("READINESS_CACHE_TTL", health.DefaultReadinessCacheTTL), "how long the result of the readiness checks is reused")
+	upstreamTimeoutGet := flag.Duration("upstream-timeout-get", env.Duration("UPSTREAM_TIMEOUT_GET", handlers.DefaultUpstreamTimeoutGet), "deadline of the Aruba Cloud calls reading a single resource, including retries")
+	upstreamTimeoutList := flag.Duration("upstream-timeout-list", env.Duration("UPSTREAM_TIMEOUT_LIST", handlers.DefaultUpstreamTimeoutList), "deadline of the Aruba Cloud calls listing resources, including retries")
+	upstreamTimeoutCreate := flag.Duration("upstream-timeout-create", env.Duration("UPSTREAM_TIMEOUT_CREATE", handlers.DefaultUpstreamTimeoutCreate), "deadline of the Aruba Cloud calls creating a resource, including retries")
+	upstreamTimeoutUpdate := flag.Duration("upstream-timeout-update", env.Duration("UPSTREAM_TIMEOUT_UPDATE", handlers.DefaultUpstreamTimeoutUpdate), "deadline of the Aruba Cloud calls updating a resource, including retries")
+	upstreamTimeoutDelete := flag.Duration("upstream-timeout-delete", env.Duration("UPSTREAM_TIMEOUT_DELETE", handlers.DefaultUpstreamTimeoutDelete), "deadline of the Aruba Cloud calls deleting a resource, including retries")
+	secretsAllowedNamespaces := flag.String("secrets-allowed-namespaces", env.String("SECRETS_ALLOWED_NAMESPACES", ""), "comma-separated namespaces of the Kubernetes Secrets the requests can reference, the namespace of the pod if empty")
+	readinessTimeout := flag.Duration("readiness-timeout", env.Duration("READINESS_TIMEOUT", health.DefaultReadinessTimeout), "timeout of the readiness checks of the dependencies")
 
 	flag.Parse()
 
@@ -39,27 +90,212 @@ func New() *Server {
 		zerolog.SetGlobalLevel(zerolog.DebugLevel)
 	}
 
-	log.Logger = log.Output(zerolog.ConsoleWriter{
-		Out:     os.Stderr,
-		NoColor: *noColor,
-	}).With().Timestamp().Logger()
+	logWriter, err := logging.NewWriter(*logFormat, os.Stderr, *noColor)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid log format")
+	}
+	log.Logger = zerolog.New(logWriter).With().Timestamp().Logger()
+	logger := logging.New(log.Logger)
+
+	validBaseURL, err := ValidateBaseURL(*baseURL)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid Aruba Cloud base URL")
+	}
+	log.Info().Msgf("using Aruba Cloud base URL %s", validBaseURL)
+
+	// Client credentials are optional: without them the Authorization header of the incoming requests is required
+	var tokenProvider auth.TokenProvider
+	if *credentialsPath != "" || os.Getenv(auth.ClientIDEnv) != "" {
+		if _, err := auth.LoadCredentials(*credentialsPath); err != nil {
+			log.Fatal().Err(err).Msg("invalid Aruba Cloud client credentials")
+		}
+		if _, err := ValidateBaseURL(*tokenURL); err != nil {
+			log.Fatal().Err(err).Msg("invalid token endpoint URL")
+		}
+		tokenProvider = auth.NewClientCredentialsProvider(auth.Options{
+			TokenURL:        *tokenURL,
+			CredentialsPath: *credentialsPath,
+			Client:          &http.Client{Timeout: 10 * time.Second},
+		})
+		log.Info().Msgf("client credentials authentication enabled with token endpoint %s", *tokenURL)
+	}
+
+	timeouts := handlers.UpstreamTimeouts{
+		Get:    *upstreamTimeoutGet,
+		List:   *upstreamTimeoutList,
+		Create: *upstreamTimeoutCreate,
+		Update: *upstreamTimeoutUpdate,
+		Delete: *upstreamTimeoutDelete,
+	}
+	for _, timeout := range []time.Duration{timeouts.Get, timeouts.List, timeouts.Create, timeouts.Update, timeouts.Delete} {
+		if timeout >= writeTimeout {
+			log.Warn().Msgf("upstream timeout %s is not below the server write timeout %s, the plugin may not be able to report it", timeout, writeTimeout)
+		}
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background())
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to set up tracing")
+	}
+	if tracing.Enabled() {
+		log.Info().Msg("OpenTelemetry tracing enabled with OTLP exporter")
+	}
+
+	// Every retry attempt is a separate upstream call in the metrics
+	m := metrics.New()
+	mux.Handle("GET /metrics", m.Handler())
+
+	client := handlers.NewRetryClient(m.InstrumentClient(&http.Client{Timeout: *clientTimeout}), handlers.RetryOptions{
+		MaxAttempts:    *retryMaxAttempts,
+		InitialBackoff: *retryInitialBackoff,
+		MaxBackoff:     *retryMaxBackoff,
+	})
+
+	// Aruba Cloud is probed without retries: a failing probe must be reported, not hidden
+	checkers := []health.Checker{
+		health.HTTPChecker("arubacloud", validBaseURL, &http.Client{Timeout: *readinessTimeout}),
+	}
+	if tokenProvider != nil {
+		checkers = append(checkers, health.CheckerFunc("arubacloud-auth", func(ctx context.Context) error {
+			_, err := tokenProvider.Token(ctx)
+			return err
+		}))
+	}
 
 	return &Server{
 		Server: &http.Server{
 			Addr:         fmt.Sprintf(":%d", *port),
-			Handler:      mux,
+			Handler:      newHandler(logger, m, mux),
 			ReadTimeout:  10 * time.Second,
-			WriteTimeout: 50 * time.Second,
+			WriteTimeout: writeTimeout,
 			IdleTimeout:  30 * time.Second,
 		},
-		mux: mux,
+		mux:      mux,
+		baseURL:  validBaseURL,
+		auth:     tokenProvider,
+		secrets:  secretsSetup{allowedNamespaces: SplitList(*secretsAllowedNamespaces)},
+		client:   client,
+		logger:   logger,
+		timeouts: timeouts,
+		metrics:  m,
+
+		readinessOpts: health.ReadinessOptions{
+			CacheTTL: *readinessCacheTTL,
+			Timeout:  *readinessTimeout,
+		},
+		readinessCheckers: checkers,
+
+		shutdownTracing: shutdownTracing,
+	}
+}
+
+// newHandler serves the requests routed by mux, traced, logged and recorded in the metrics m
+func newHandler(logger logging.Logger, m *metrics.Metrics, mux *http.ServeMux) http.Handler {
+	return tracing.Middleware(logging.Middleware(logger, m.Middleware(tracing.Route(mux))))
+}
+
+// ValidateBaseURL checks that raw is an absolute http(s) URL without query or fragment
+// and returns it normalized without the trailing slash, ready to be joined with API paths
+func ValidateBaseURL(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", fmt.Errorf("failed to parse base URL %q: %w", raw, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("base URL %q must use http or https scheme", raw)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("base URL %q must include a host", raw)
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("base URL %q must not include query or fragment", raw)
+	}
+
+	return strings.TrimRight(u.String(), "/"), nil
+}
+
+// SplitList returns the non-empty items of a comma-separated list, without surrounding spaces
+func SplitList(raw string) []string {
+	var items []string
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
 	}
+	return items
 }
 
 func (s *Server) Mux() *http.ServeMux {
 	return s.mux
 }
 
+// BaseURL returns the validated Aruba Cloud API base URL
+func (s *Server) BaseURL() string {
+	return s.baseURL
+}
+
+// TokenProvider returns the client credentials token provider, nil if not configured
+func (s *Server) TokenProvider() auth.TokenProvider {
+	return s.auth
+}
+
+// SecretResolver returns the resolver of the Kubernetes Secrets referenced by the requests, reading them
+// from the Kubernetes API of the pod. Only the plugins reading Secrets should call it, to set up the resolver.
+// It returns nil outside a cluster, or with a warning when the service account of the pod cannot be read.
+func (s *Server) SecretResolver() secrets.Resolver {
+	s.secrets.once.Do(func() {
+		if !secrets.InCluster() {
+			log.Warn().Msg("not running in a Kubernetes cluster, the Kubernetes Secrets referenced by the requests cannot be read")
+			return
+		}
+		opts, err := secrets.InClusterOptions()
+		if err != nil {
+			log.Warn().Err(err).Msg("invalid in-cluster Kubernetes configuration, the Kubernetes Secrets referenced by the requests cannot be read")
+			return
+		}
+		if len(s.secrets.allowedNamespaces) > 0 {
+			opts.AllowedNamespaces = s.secrets.allowedNamespaces
+		}
+		s.secrets.resolver = secrets.NewKubernetesResolver(opts)
+		log.Info().Msgf("Kubernetes Secrets resolution enabled with API %s in the namespaces %s", opts.Host, strings.Join(opts.AllowedNamespaces, ", "))
+	})
+	return s.secrets.resolver
+}
+
+// HTTPClient returns the client for Aruba Cloud calls, retrying transient failures.
+// Calls are recorded in the metrics, labeled with the operation set with metrics.WithOperation.
+func (s *Server) HTTPClient() handlers.HTTPClient {
+	return s.client
+}
+
+// Logger returns the structured logger of the handlers.
+// Handlers should prefer logging.FromContext, which adds the request ID.
+func (s *Server) Logger() logging.Logger {
+	return s.logger
+}
+
+// UpstreamTimeouts returns the deadlines of the Aruba Cloud calls, by kind of operation
+func (s *Server) UpstreamTimeouts() handlers.UpstreamTimeouts {
+	return s.timeouts
+}
+
+// Metrics returns the Prometheus metrics served at /metrics
+func (s *Server) Metrics() *metrics.Metrics {
+	return s.metrics
+}
+
+// RegisterReadinessCheck adds checkers to the dependencies verified by the readiness probe.
+// The Aruba Cloud API and, when configured, the token endpoint are registered by default.
+// It must be called before ReadinessHandler.
+func (s *Server) RegisterReadinessCheck(checkers ...health.Checker) {
+	s.readinessCheckers = append(s.readinessCheckers, checkers...)
+}
+
+// ReadinessHandler returns the readiness probe checking every registered dependency
+func (s *Server) ReadinessHandler() http.HandlerFunc {
+	return health.ReadinessHandler(&s.ready, s.readinessOpts, s.readinessCheckers...)
+}
+
 func (s *Server) Healthy() *int32 {
 	return &s.healthy
 }
@@ -104,6 +340,10 @@ func (s *Server) Run() {
 		log.Fatal().Err(err).Msg("server forced to shutdown")
 	}
 
+	if err := s.shutdownTracing(ctx); err != nil {
+		log.Error().Err(err).Msg("failed to flush pending spans")
+	}
+
 	atomic.StoreInt32(&s.healthy, 0)
 	log.Info().Msg("server gracefully stopped")
 }
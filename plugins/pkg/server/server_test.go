@@ -0,0 +1,193 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/logging"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/metrics"
+	"github.com/krateoplatformops/arubacloud-provider-kog/pkg/secrets"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// TestValidateBaseURL tests the ValidateBaseURL function
+func TestValidateBaseURL(t *testing.T) {
+	testCases := []struct {
+		name           string
+		input          string
+		expected       string
+		expectErr      bool
+		expectedErrMsg string
+	}{
+		{
+			name:     "default Aruba Cloud endpoint",
+			input:    "https://api.arubacloud.com",
+			expected: "https://api.arubacloud.com",
+		},
+		{
+			name:     "trailing slash is removed",
+			input:    "https://api.arubacloud.com/",
+			expected: "https://api.arubacloud.com",
+		},
+		{
+			name:     "path prefix is preserved",
+			input:    "http://egress-proxy.internal:3128/aruba/",
+			expected: "http://egress-proxy.internal:3128/aruba",
+		},
+		{
+			name:     "surrounding spaces are ignored",
+			input:    "  http://localhost:9090  ",
+			expected: "http://localhost:9090",
+		},
+		{
+			name:           "unsupported scheme",
+			input:          "ftp://api.arubacloud.com",
+			expectErr:      true,
+			expectedErrMsg: "must use http or https scheme",
+		},
+		{
+			name:           "missing scheme",
+			input:          "api.arubacloud.com",
+			expectErr:      true,
+			expectedErrMsg: "must use http or https scheme",
+		},
+		{
+			name:           "missing host",
+			input:          "https://",
+			expectErr:      true,
+			expectedErrMsg: "must include a host",
+		},
+		{
+			name:           "query string not allowed",
+			input:          "https://api.arubacloud.com?api-version=1.0",
+			expectErr:      true,
+			expectedErrMsg: "must not include query or fragment",
+		},
+		{
+			name:           "empty value",
+			input:          "",
+			expectErr:      true,
+			expectedErrMsg: "must use http or https scheme",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := ValidateBaseURL(tc.input)
+
+			if tc.expectErr {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				if !strings.Contains(err.Error(), tc.expectedErrMsg) {
+					t.Errorf("expected error message to contain '%s', but got '%s'", tc.expectedErrMsg, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("did not expect an error but got: %v", err)
+			}
+			if result != tc.expected {
+				t.Errorf("expected '%s', but got '%s'", tc.expected, result)
+			}
+		})
+	}
+}
+
+// TestSplitList tests the SplitList function
+func TestSplitList(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{name: "single item", input: "krateo-system", expected: []string{"krateo-system"}},
+		{name: "spaces and empty items", input: " team-a, ,team-b ,", expected: []string{"team-a", "team-b"}},
+		{name: "empty value", input: "", expected: nil},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := SplitList(tc.input); !slices.Equal(result, tc.expected) {
+				t.Errorf("expected %q, but got %q", tc.expected, result)
+			}
+		})
+	}
+}
+
+// TestHandler tests that the route matched by the ServeMux reaches the tracing, logging and metrics middlewares
+func TestHandler(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	var logs bytes.Buffer
+	m := metrics.New()
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", m.Handler())
+	mux.HandleFunc("GET /a/{id}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+	handler := newHandler(logging.New(zerolog.New(&logs)), m, mux)
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/a/1", nil))
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(spans))
+	}
+	if spans[0].Name() != "GET /a/{id}" {
+		t.Errorf("expected the server span to be named after the route, got '%s'", spans[0].Name())
+	}
+	if !slices.Contains(spans[0].Attributes(), semconv.HTTPRoute("GET /a/{id}")) {
+		t.Errorf("expected the server span to carry the route, got %v", spans[0].Attributes())
+	}
+
+	var summary map[string]any
+	if err := json.Unmarshal(logs.Bytes(), &summary); err != nil {
+		t.Fatalf("failed to unmarshal the request summary: %v", err)
+	}
+	if summary[logging.FieldRoute] != "GET /a/{id}" {
+		t.Errorf("expected the request summary to carry the route, got %v", summary[logging.FieldRoute])
+	}
+
+	scrape := httptest.NewRecorder()
+	handler.ServeHTTP(scrape, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if !strings.Contains(scrape.Body.String(), `route="/a/{id}"`) {
+		t.Errorf("expected the metrics to carry the route, got:\n%s", scrape.Body.String())
+	}
+}
+
+// TestSecretResolver tests that the Secret resolver is left unset, without exiting, when the Kubernetes API cannot be reached
+func TestSecretResolver(t *testing.T) {
+	testCases := []struct {
+		name string
+		host string
+	}{
+		{name: "outside a cluster"},
+		{name: "service account not mounted", host: "10.96.0.1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv(secrets.ServiceHostEnv, tc.host)
+			t.Setenv(secrets.ServicePortEnv, "443")
+
+			s := &Server{}
+			if resolver := s.SecretResolver(); resolver != nil {
+				t.Errorf("expected no resolver, got %T", resolver)
+			}
+		})
+	}
+}
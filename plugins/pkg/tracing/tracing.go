@@ -0,0 +1,204 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName identifies the tracer of the plugins
+const InstrumentationName = "github.com/krateoplatformops/arubacloud-provider-kog/pkg/tracing"
+
+// Environment variables of the OTLP exporter, as defined by the OpenTelemetry specification.
+// Tracing is enabled when either endpoint is set; every other OTEL_EXPORTER_OTLP_* variable
+// (headers, timeout, insecure, ...) is honored by the exporter.
+const (
+	EndpointEnv       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	TracesEndpointEnv = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+	SDKDisabledEnv    = "OTEL_SDK_DISABLED"
+)
+
+// Enabled reports whether the environment configures an OTLP exporter
+func Enabled() bool {
+	if disabled, _ := strconv.ParseBool(os.Getenv(SDKDisabledEnv)); disabled {
+		return false
+	}
+	return os.Getenv(EndpointEnv) != "" || os.Getenv(TracesEndpointEnv) != ""
+}
+
+// Setup installs the W3C trace context and baggage propagators and, when Enabled, a tracer provider
+// exporting spans with OTLP over HTTP. Otherwise the default no-op tracer provider is kept, which
+// still forwards the incoming trace context to Aruba Cloud.
+// The service name is OTEL_SERVICE_NAME if set, the executable name otherwise.
+// The returned function flushes the pending spans and must be called before exiting.
+func Setup(ctx context.Context) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if !Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+
+	res, err := resource.Merge(
+		resource.NewSchemaless(semconv.ServiceName(filepath.Base(os.Args[0]))),
+		resource.Environment(), // OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES take precedence
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	// The sampler is read from OTEL_TRACES_SAMPLER, parent-based always-on by default
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
+
+// statusRecorder captures the status code written by a handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// routeKey is the context key of the holder of the ServeMux pattern matched for the request
+type routeKey struct{}
+
+// Middleware extracts the W3C trace context of the incoming requests and serves each of them in a server span.
+// The span is named after the matched ServeMux pattern once next has routed the request: the middlewares
+// in between may serve a copy of the request, so the ServeMux must be wrapped with Route.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracer().Start(ctx, r.Method,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPRequestMethodKey.String(r.Method),
+				semconv.URLPath(r.URL.Path),
+			),
+		)
+		defer span.End()
+
+		route := new(string)
+		r = r.WithContext(context.WithValue(ctx, routeKey{}, route))
+		rec := &statusRecorder{ResponseWriter: w}
+		next.ServeHTTP(rec, r)
+
+		if r.Pattern != "" {
+			*route = r.Pattern
+		}
+		if *route != "" {
+			span.SetName(*route)
+			span.SetAttributes(semconv.HTTPRoute(*route))
+		}
+		if rec.status == 0 {
+			rec.status = http.StatusOK
+		}
+		span.SetAttributes(semconv.HTTPResponseStatusCode(rec.status))
+		if rec.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(rec.status))
+		}
+	})
+}
+
+// Route records the pattern matched by mux for the server span started by Middleware
+func Route(mux http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.ServeHTTP(w, r)
+		if route, ok := r.Context().Value(routeKey{}).(*string); ok {
+			*route = r.Pattern
+		}
+	})
+}
+
+// Phases traces the sequential phases of a handler (e.g. validate, upstream, unmarshal, flatten)
+// as sibling spans, children of the span of the request
+type Phases struct {
+	ctx  context.Context
+	span trace.Span
+}
+
+// StartPhases returns the phases of the handler serving the request with context ctx.
+// End must be called when the handler returns.
+func StartPhases(ctx context.Context) *Phases {
+	return &Phases{ctx: ctx}
+}
+
+// Next ends the current phase and starts the one called name, returning its context
+func (p *Phases) Next(name string) context.Context {
+	p.End()
+	ctx, span := tracer().Start(p.ctx, name)
+	p.span = span
+	return ctx
+}
+
+// End ends the current phase, if any
+func (p *Phases) End() {
+	if p.span != nil {
+		p.span.End()
+		p.span = nil
+	}
+}
+
+// StartClient starts a client span for a call to Aruba Cloud and injects its trace context
+// into the headers of req. The returned function records the outcome of the call and ends the span.
+func StartClient(req *http.Request, operation string) (*http.Request, func(*http.Response, error)) {
+	ctx, span := tracer().Start(req.Context(), fmt.Sprintf("%s %s", req.Method, operation),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.ServerAddress(req.URL.Hostname()),
+			semconv.URLPath(req.URL.Path),
+			attribute.String("arubacloud.operation", operation),
+		),
+	)
+	req = req.WithContext(ctx)
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	return req, func(resp *http.Response, err error) {
+		defer span.End()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return
+		}
+		span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+		if resp.StatusCode >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package tracing
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const (
+	traceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	traceparent = "00-" + traceID + "-00f067aa0ba902b7-01"
+)
+
+// newRecorder installs a tracer provider recording the ended spans
+func newRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+// TestMiddleware tests the extraction of the incoming trace context and its propagation to Aruba Cloud
+func TestMiddleware(t *testing.T) {
+	recorder := newRecorder(t)
+
+	var outgoing http.Header
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /projects/{projectId}/subnets/{id}", func(w http.ResponseWriter, r *http.Request) {
+		phases := StartPhases(r.Context())
+		defer phases.End()
+
+		phases.Next("validate")
+		ctx := phases.Next("upstream")
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://aruba.test/projects/p1/subnets/s1", nil)
+		req, end := StartClient(req, "get_subnet")
+		outgoing = req.Header
+		end(&http.Response{StatusCode: http.StatusBadGateway}, nil)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/projects/p1/subnets/s1", nil)
+	req.Header.Set("traceparent", traceparent)
+	Middleware(mux).ServeHTTP(httptest.NewRecorder(), req)
+
+	if got := outgoing.Get("traceparent"); !strings.Contains(got, traceID) {
+		t.Errorf("expected the outgoing traceparent to carry trace ID '%s', got '%s'", traceID, got)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 4 {
+		t.Fatalf("expected 4 spans, got %d", len(spans))
+	}
+	byName := make(map[string]sdktrace.ReadOnlySpan)
+	for _, s := range spans {
+		if s.SpanContext().TraceID().String() != traceID {
+			t.Errorf("span '%s' does not belong to the incoming trace", s.Name())
+		}
+		byName[s.Name()] = s
+	}
+
+	server, ok := byName["GET /projects/{projectId}/subnets/{id}"]
+	if !ok {
+		t.Fatalf("expected the server span to be named after the route")
+	}
+	for _, name := range []string{"validate", "upstream"} {
+		if phase := byName[name]; phase == nil || phase.Parent().SpanID() != server.SpanContext().SpanID() {
+			t.Errorf("expected phase '%s' to be a child of the server span", name)
+		}
+	}
+	client := byName["GET get_subnet"]
+	if client == nil || client.Parent().SpanID() != byName["upstream"].SpanContext().SpanID() {
+		t.Fatalf("expected the client span to be a child of the upstream phase")
+	}
+	if client.Status().Code.String() != "Error" || server.Status().Code.String() != "Error" {
+		t.Errorf("expected 5xx responses to mark the spans as failed")
+	}
+}
+
+// TestSetup tests that tracing is disabled unless an OTLP endpoint is configured
+func TestSetup(t *testing.T) {
+	t.Setenv(EndpointEnv, "")
+	t.Setenv(TracesEndpointEnv, "")
+	if Enabled() {
+		t.Errorf("expected tracing to be disabled without an endpoint")
+	}
+
+	t.Setenv(EndpointEnv, "http://otel-collector:4318")
+	if !Enabled() {
+		t.Errorf("expected tracing to be enabled with an endpoint")
+	}
+
+	t.Setenv(SDKDisabledEnv, "true")
+	if Enabled() {
+		t.Errorf("expected OTEL_SDK_DISABLED to disable tracing")
+	}
+	shutdown, err := Setup(t.Context())
+	if err != nil {
+		t.Fatalf("did not expect an error but got: %v", err)
+	}
+	if err := shutdown(t.Context()); err != nil {
+		t.Errorf("did not expect an error but got: %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package validation
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SSHKeyTypes are the accepted types of SSH public keys
+var SSHKeyTypes = []string{
+	"ssh-rsa",
+	"ssh-ed25519",
+	"ecdsa-sha2-nistp256",
+	"ecdsa-sha2-nistp384",
+	"ecdsa-sha2-nistp521",
+}
+
+// SSHPublicKey checks a public key in the OpenSSH authorized_keys format, e.g. "ssh-ed25519 AAAA... user@host",
+// and returns its decoded key blob
+func SSHPublicKey(value string) ([]byte, error) {
+	fields := strings.Fields(value)
+	if len(fields) < 2 {
+		return nil, errors.New("invalid SSH public key: expected '<type> <base64 key> [comment]'")
+	}
+	keyType := fields[0]
+	if !slices.Contains(SSHKeyTypes, keyType) {
+		return nil, fmt.Errorf("invalid SSH public key: type must be one of %s, got '%s'", strings.Join(SSHKeyTypes, ", "), keyType)
+	}
+	blob, err := base64.StdEncoding.DecodeString(fields[1])
+	if err != nil {
+		return nil, errors.New("invalid SSH public key: the key is not valid base64")
+	}
+	// The blob starts with the length-prefixed key type, which must match the declared one
+	if len(blob) < 4 {
+		return nil, errors.New("invalid SSH public key: the key is truncated")
+	}
+	n := binary.BigEndian.Uint32(blob)
+	if uint64(len(blob)-4) < uint64(n) || string(blob[4:4+n]) != keyType {
+		return nil, fmt.Errorf("invalid SSH public key: the key does not match the '%s' type", keyType)
+	}
+	return blob, nil
+}
+
+// SSHFingerprint returns the SHA256 fingerprint of a public key, in the format printed by ssh-keygen -l
+// (e.g. "SHA256:PCL5/6zjNRPYQeDvPwo1+gJYe3Honyo6LcXx0ktcmjU")
+func SSHFingerprint(value string) (string, error) {
+	blob, err := SSHPublicKey(value)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(blob)
+	return "SHA256:" + base64.RawStdEncoding.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,75 @@
+package validation
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// AnyPort is the port value matching every port
+const AnyPort = "*"
+
+// OneOf checks that value is one of the allowed values. Field names the value in the error.
+func OneOf(field, value string, allowed ...string) error {
+	if !slices.Contains(allowed, value) {
+		return fmt.Errorf("%s must be one of %s, got '%s'", field, strings.Join(allowed, ", "), value)
+	}
+	return nil
+}
+
+// PortRange checks a port specification: a single port (e.g. "443"), a range (e.g. "8000-8080") or AnyPort
+func PortRange(value string) error {
+	if value == AnyPort {
+		return nil
+	}
+	from, to, isRange := strings.Cut(value, "-")
+	first, err := port(from)
+	if err != nil {
+		return err
+	}
+	if !isRange {
+		return nil
+	}
+	last, err := port(to)
+	if err != nil {
+		return err
+	}
+	if first > last {
+		return fmt.Errorf("invalid port range '%s': the first port is greater than the last one", value)
+	}
+	return nil
+}
+
+// Port checks that value is a port number, between 1 and 65535. Field names the value in the error.
+func Port(field string, value int32) error {
+	if value < 1 || value > 65535 {
+		return fmt.Errorf("%s must be a port between 1 and 65535, got %d", field, value)
+	}
+	return nil
+}
+
+func port(value string) (int, error) {
+	p, err := strconv.Atoi(value)
+	if err != nil || p < 1 || p > 65535 {
+		return 0, fmt.Errorf("invalid port '%s': must be a number between 1 and 65535", value)
+	}
+	return p, nil
+}
+
+// CIDR parses an address in CIDR notation (e.g. "10.0.0.0/24"). A bare IP address is accepted as a single host prefix.
+func CIDR(value string) (netip.Prefix, error) {
+	if !strings.Contains(value, "/") {
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return netip.Prefix{}, fmt.Errorf("invalid address '%s': must be an IP address or a CIDR", value)
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("invalid CIDR '%s'", value)
+	}
+	return prefix, nil
+}
@@ -0,0 +1,85 @@
+package validation
+
+import (
+	"testing"
+)
+
+func TestOneOf(t *testing.T) {
+	if err := OneOf("direction", "Ingress", "Ingress", "Egress"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	err := OneOf("direction", "Inbound", "Ingress", "Egress")
+	if err == nil || err.Error() != "direction must be one of Ingress, Egress, got 'Inbound'" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestPortRange(t *testing.T) {
+	testCases := []struct {
+		value   string
+		wantErr bool
+	}{
+		{value: "*"},
+		{value: "22"},
+		{value: "8000-8080"},
+		{value: "80-80"},
+		{value: "", wantErr: true},
+		{value: "0", wantErr: true},
+		{value: "65536", wantErr: true},
+		{value: "http", wantErr: true},
+		{value: "8080-8000", wantErr: true},
+		{value: "80-", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			err := PortRange(tc.value)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("PortRange(%q) error = %v, wantErr %v", tc.value, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestPort(t *testing.T) {
+	for _, value := range []int32{1, 443, 65535} {
+		if err := Port("port", value); err != nil {
+			t.Errorf("Port(%d) unexpected error: %v", value, err)
+		}
+	}
+	err := Port("port", 0)
+	if err == nil || err.Error() != "port must be a port between 1 and 65535, got 0" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := Port("port", 65536); err == nil {
+		t.Error("expected an error for 65536")
+	}
+}
+
+func TestCIDR(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected string
+		wantErr  bool
+	}{
+		{value: "10.0.0.0/24", expected: "10.0.0.0/24"},
+		{value: "0.0.0.0/0", expected: "0.0.0.0/0"},
+		{value: "192.168.1.10", expected: "192.168.1.10/32"},
+		{value: "2001:db8::/32", expected: "2001:db8::/32"},
+		{value: "10.0.0.0/33", wantErr: true},
+		{value: "10.0.0", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			prefix, err := CIDR(tc.value)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("CIDR(%q) error = %v, wantErr %v", tc.value, err, tc.wantErr)
+			}
+			if !tc.wantErr && prefix.String() != tc.expected {
+				t.Errorf("CIDR(%q) = %s, expected %s", tc.value, prefix, tc.expected)
+			}
+		})
+	}
+}
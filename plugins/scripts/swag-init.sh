@@ -36,9 +36,12 @@ echo "Generating OpenAPI documentation for ${PLUGIN_NAME}..."
 MAIN_GO_PATH="${PLUGIN_DIR}/main.go"
 OUTPUT_DIR="${PLUGIN_DIR}/docs"
 
+# The other plugins are excluded, otherwise their endpoints would end up in this plugin's documentation
+EXCLUDE_DIRS=$(ls -d cmd/*-plugin | grep -v "^${PLUGIN_DIR}$" | paste -sd, -)
+
 # Generate the Swagger v2 JSON file
 # The --output flag sets the destination directory for the 'docs' folder
-swag init --parseDependency -g "${MAIN_GO_PATH}" --output "${OUTPUT_DIR}"
+swag init --parseDependency -g "${MAIN_GO_PATH}" --exclude "${EXCLUDE_DIRS}" --output "${OUTPUT_DIR}"
 
 # Define the input and output paths for the conversion script
 SWAGGER_V2_JSON="${OUTPUT_DIR}/swagger.json"